@@ -0,0 +1,50 @@
+package pagerduty
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// listAbilities returns the abilities of the account. The list is only
+// requested once per provider run and then served from memory.
+func (c *Config) listAbilities() ([]string, error) {
+	c = c.root()
+	c.abilitiesMu.Lock()
+	defer c.abilitiesMu.Unlock()
+
+	if c.abilities != nil {
+		return c.abilities, nil
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	var abilities []string
+	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+		resp, _, err := client.Abilities.List()
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusForbidden) {
+				return retry.NonRetryableError(err)
+			}
+
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+		abilities = resp.Abilities
+		return nil
+	})
+	if retryErr != nil {
+		return nil, retryErr
+	}
+
+	if abilities == nil {
+		abilities = []string{}
+	}
+	c.abilities = abilities
+
+	return c.abilities, nil
+}
@@ -0,0 +1,190 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
+)
+
+// uuidPathSegmentRegexp matches the UUIDs identifying some objects of the API
+// in the paths of their endpoints, e.g. the rules of the rulesets.
+var uuidPathSegmentRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// apiDeprecation is a deprecation notice attached by the API to the responses
+// of a deprecated endpoint, through their Warning, Deprecation and Sunset
+// headers.
+type apiDeprecation struct {
+	endpoint    string
+	warning     string
+	deprecation string
+	sunset      string
+}
+
+// apiDeprecations collects the deprecation notices of the API responses, so
+// each one is reported only once per run however many requests got it.
+type apiDeprecations struct {
+	mu      sync.Mutex
+	seen    map[apiDeprecation]bool
+	pending []apiDeprecation
+}
+
+func (s *apiDeprecations) record(req *http.Request, resp *http.Response) {
+	if s == nil || resp == nil {
+		return
+	}
+
+	d := apiDeprecation{
+		endpoint:    req.Method + " " + apiEndpointPattern(req.URL.Path),
+		warning:     resp.Header.Get("Warning"),
+		deprecation: resp.Header.Get("Deprecation"),
+		sunset:      resp.Header.Get("Sunset"),
+	}
+	if d.warning == "" && d.deprecation == "" && d.sunset == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.seen[d] {
+		return
+	}
+	if s.seen == nil {
+		s.seen = map[apiDeprecation]bool{}
+	}
+	s.seen[d] = true
+	s.pending = append(s.pending, d)
+}
+
+// diagnostics returns the deprecation notices recorded since it was last
+// called as warnings.
+func (s *apiDeprecations) diagnostics() diag.Diagnostics {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var diags diag.Diagnostics
+	for _, d := range s.pending {
+		details := []string{fmt.Sprintf("PagerDuty reported the endpoint %s used by the provider as deprecated.", d.endpoint)}
+		if d.warning != "" {
+			details = append(details, fmt.Sprintf("Warning: %s.", strings.TrimSuffix(d.warning, ".")))
+		}
+		if d.deprecation != "" {
+			details = append(details, fmt.Sprintf("Deprecated since: %s.", d.deprecation))
+		}
+		if d.sunset != "" {
+			details = append(details, fmt.Sprintf("The endpoint stops working after %s, upgrade the provider before then or check its changelog for the replacement.", d.sunset))
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Deprecated PagerDuty API endpoint: %s", d.endpoint),
+			Detail:   strings.Join(details, " "),
+		})
+	}
+	s.pending = nil
+
+	return diags
+}
+
+// apiEndpointPattern replaces the IDs in the path of a request with a
+// placeholder, so the requests of different objects to the same endpoint are
+// told as one.
+func apiEndpointPattern(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if util.IsPagerDutyID(s) || uuidPathSegmentRegexp.MatchString(s) {
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// deprecationTransport records the deprecation notices of the API responses.
+type deprecationTransport struct {
+	transport    http.RoundTripper
+	deprecations *apiDeprecations
+}
+
+func newDeprecationTransport(transport http.RoundTripper, deprecations *apiDeprecations) *deprecationTransport {
+	return &deprecationTransport{transport: transport, deprecations: deprecations}
+}
+
+func (t *deprecationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err == nil {
+		t.deprecations.record(req, resp)
+	}
+
+	return resp, err
+}
+
+// reportAPIDeprecations wraps the operations of the resource or data source
+// r, so the deprecation notices received from the API while they run are
+// added to their diagnostics as warnings.
+func reportAPIDeprecations(r *schema.Resource) {
+	type contextFunc = func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics
+	type legacyFunc = func(*schema.ResourceData, interface{}) error
+
+	wrap := func(f contextFunc) contextFunc {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			diags := f(ctx, d, meta)
+			if c, ok := meta.(*Config); ok {
+				diags = append(diags, c.root().deprecations.diagnostics()...)
+			}
+			return diags
+		}
+	}
+	legacy := func(f legacyFunc) contextFunc {
+		return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(f(d, meta))
+		}
+	}
+
+	switch {
+	case r.CreateContext != nil:
+		r.CreateContext = wrap(r.CreateContext)
+	case r.CreateWithoutTimeout != nil:
+		r.CreateWithoutTimeout = wrap(r.CreateWithoutTimeout)
+	case r.Create != nil:
+		r.CreateContext = wrap(legacy(r.Create))
+		r.Create = nil
+	}
+
+	switch {
+	case r.ReadContext != nil:
+		r.ReadContext = wrap(r.ReadContext)
+	case r.ReadWithoutTimeout != nil:
+		r.ReadWithoutTimeout = wrap(r.ReadWithoutTimeout)
+	case r.Read != nil:
+		r.ReadContext = wrap(legacy(r.Read))
+		r.Read = nil
+	}
+
+	switch {
+	case r.UpdateContext != nil:
+		r.UpdateContext = wrap(r.UpdateContext)
+	case r.UpdateWithoutTimeout != nil:
+		r.UpdateWithoutTimeout = wrap(r.UpdateWithoutTimeout)
+	case r.Update != nil:
+		r.UpdateContext = wrap(legacy(r.Update))
+		r.Update = nil
+	}
+
+	switch {
+	case r.DeleteContext != nil:
+		r.DeleteContext = wrap(r.DeleteContext)
+	case r.DeleteWithoutTimeout != nil:
+		r.DeleteWithoutTimeout = wrap(r.DeleteWithoutTimeout)
+	case r.Delete != nil:
+		r.DeleteContext = wrap(legacy(r.Delete))
+		r.Delete = nil
+	}
+}
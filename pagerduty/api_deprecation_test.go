@@ -0,0 +1,76 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestReportAPIDeprecations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Warning", `299 - "Rulesets are deprecated, migrate to Event Orchestrations"`)
+		w.Header().Set("Sunset", "Mon, 06 Jan 2025 00:00:00 GMT")
+		id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		w.Write([]byte(`{"rule":{"id":"` + id + `","position":0}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := Provider(IsNotMuxed).ResourcesMap["pagerduty_ruleset_rule"]
+	read := func(id string) diag.Diagnostics {
+		d := r.TestResourceData()
+		d.SetId(id)
+		d.Set("ruleset", "PRSET12")
+		diags := r.ReadContext(context.Background(), d, config)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		return diags
+	}
+
+	diags := read("0b9e4d3c-2f5a-4a1e-9a8c-1d2e3f4a5b6c")
+	if len(diags) != 1 {
+		t.Fatalf("expected a single warning about the deprecated endpoint, got %v", diags)
+	}
+	if diags[0].Severity != diag.Warning {
+		t.Errorf("expected the deprecation to be reported as a warning, got %v", diags[0].Severity)
+	}
+	if expected := "Deprecated PagerDuty API endpoint: GET /rulesets/{id}/rules/{id}"; diags[0].Summary != expected {
+		t.Errorf("expected the summary %q, got %q", expected, diags[0].Summary)
+	}
+	for _, s := range []string{"Rulesets are deprecated, migrate to Event Orchestrations", "Mon, 06 Jan 2025 00:00:00 GMT"} {
+		if !strings.Contains(diags[0].Detail, s) {
+			t.Errorf("expected the detail to contain %q, got %q", s, diags[0].Detail)
+		}
+	}
+
+	// The same deprecation is only reported once per run.
+	if diags := read("7c6b5a4d-3e2f-4a1b-8c9d-0e1f2a3b4c5d"); len(diags) != 0 {
+		t.Errorf("expected the deprecation to be reported once, got %v", diags)
+	}
+}
+
+func TestAPIEndpointPattern(t *testing.T) {
+	cases := map[string]string{
+		"/services/PSVC123":                              "/services/{id}",
+		"/services/PSVC123/integrations/PINT456":         "/services/{id}/integrations/{id}",
+		"/rulesets/0b9e4d3c-2f5a-4a1e-9a8c-1d2e3f4a5b6c": "/rulesets/{id}",
+		"/incidents/custom_fields":                       "/incidents/custom_fields",
+		"/event_orchestrations/services/PSVC123/active":  "/event_orchestrations/services/{id}/active",
+	}
+	for path, expected := range cases {
+		if got := apiEndpointPattern(path); got != expected {
+			t.Errorf("expected the pattern of %s to be %s, got %s", path, expected, got)
+		}
+	}
+}
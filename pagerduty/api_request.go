@@ -0,0 +1,101 @@
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// getAPIResource gets the API endpoint at path and decodes its response into
+// v, for the endpoints the API client has no method for. The request is built
+// the same way the client builds its own and sent through its HTTP client,
+// failed responses are returned as errors of the API client so they can be
+// checked with isErrCode.
+func getAPIResource(ctx context.Context, client *pagerduty.Client, path string, query url.Values, v interface{}) error {
+	return doAPIRequest(ctx, client, http.MethodGet, path, query, nil, nil, v)
+}
+
+// putAPIResource sends payload JSON encoded to the API endpoint at path and
+// decodes its response into v, for the payloads the API client can't encode,
+// like the fields its types drop when they have their zero value.
+func putAPIResource(ctx context.Context, client *pagerduty.Client, path string, payload, v interface{}) error {
+	return doAPIRequest(ctx, client, http.MethodPut, path, nil, nil, payload, v)
+}
+
+// postAPIResource sends payload JSON encoded to the API endpoint at path with
+// the extra headers given, like the From header of the requests acting as a
+// user the API client doesn't support, and decodes its response into v.
+func postAPIResource(ctx context.Context, client *pagerduty.Client, path string, header http.Header, payload, v interface{}) error {
+	return doAPIRequest(ctx, client, http.MethodPost, path, nil, header, payload, v)
+}
+
+func doAPIRequest(ctx context.Context, client *pagerduty.Client, method, path string, query url.Values, header http.Header, payload, v interface{}) error {
+	u := strings.TrimSuffix(client.Config.BaseURL, "/") + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var reqBody io.Reader
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Accept", "application/vnd.pagerduty+json;version=2")
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("User-Agent", client.Config.UserAgent)
+
+	authHeader := fmt.Sprintf("Token token=%s", client.Config.Token)
+	if t := client.Config.APIAuthTokenType; t != nil && (*t == pagerduty.AuthTokenTypeUseAppCredentials || *t == pagerduty.AuthTokenTypeScopedOauthToken) {
+		authHeader = fmt.Sprintf("Bearer %s", client.Config.AppOauthScopedTokenParams.Token)
+	}
+	req.Header.Add("Authorization", authHeader)
+	for k, values := range header {
+		for _, value := range values {
+			req.Header.Add(k, value)
+		}
+	}
+
+	httpClient := client.Config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		apiErr := &pagerduty.Error{ErrorResponse: &pagerduty.Response{Response: resp, BodyBytes: body}}
+		json.Unmarshal(body, &struct {
+			Error *pagerduty.Error `json:"error"`
+		}{Error: apiErr})
+		return apiErr
+	}
+
+	if v == nil {
+		return nil
+	}
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,97 @@
+package pagerduty
+
+import (
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// defaultRateLimitWait is the wait of the API client before retrying the
+// requests which were rate limited without a ratelimit-reset header.
+const defaultRateLimitWait = 5 * time.Second
+
+// clientStats counts the requests made to the API during a run, and the
+// retries and waits they needed, teams check them to tune the parallelism of
+// their runs.
+type clientStats struct {
+	requests    atomic.Int64
+	retries     atomic.Int64
+	rateLimited atomic.Int64
+	wait        atomic.Int64
+}
+
+func (s *clientStats) recordRequest() {
+	if s != nil {
+		s.requests.Add(1)
+	}
+}
+
+func (s *clientStats) recordRetry(wait time.Duration) {
+	if s != nil {
+		s.retries.Add(1)
+		s.wait.Add(int64(wait))
+	}
+}
+
+func (s *clientStats) recordRateLimited(wait time.Duration) {
+	if s != nil {
+		s.rateLimited.Add(1)
+		s.recordRetry(wait)
+	}
+}
+
+func (s *clientStats) totalRequests() int64 {
+	return s.requests.Load()
+}
+
+func (s *clientStats) totalRetries() int64 {
+	return s.retries.Load()
+}
+
+func (s *clientStats) totalRateLimited() int64 {
+	return s.rateLimited.Load()
+}
+
+func (s *clientStats) totalWait() time.Duration {
+	return time.Duration(s.wait.Load())
+}
+
+// statsTransport counts each attempt of the requests to the API. The API
+// client retries the rate limited requests after waiting the time in their
+// ratelimit-reset header, so they're counted as retries waiting that long.
+type statsTransport struct {
+	transport http.RoundTripper
+	stats     *clientStats
+}
+
+func newStatsTransport(transport http.RoundTripper, stats *clientStats) *statsTransport {
+	return &statsTransport{transport: transport, stats: stats}
+}
+
+func (t *statsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.stats.recordRequest()
+
+	resp, err := t.transport.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusTooManyRequests {
+		t.stats.recordRateLimited(rateLimitWait(resp))
+	}
+
+	return resp, err
+}
+
+// rateLimitWait returns the wait announced by a rate limited response, it
+// doesn't include the jitter the API client adds to it.
+func rateLimitWait(resp *http.Response) time.Duration {
+	reset := resp.Header.Get("ratelimit-reset")
+	if reset == "" {
+		return defaultRateLimitWait
+	}
+
+	seconds, err := strconv.ParseInt(reset, 10, 0)
+	if err != nil {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
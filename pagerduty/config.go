@@ -6,6 +6,7 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -24,7 +25,7 @@ type Config struct {
 	// Override default PagerDuty API URL
 	ApiUrlOverride string
 
-	// The PagerDuty APP URL
+	// The PagerDuty APP URL, derived from ServiceRegion when empty
 	AppUrl string
 
 	// The PagerDuty API V2 token
@@ -39,19 +40,85 @@ type Config struct {
 	// UserAgent for API Client
 	UserAgent string
 
+	// Appended to UserAgent, e.g. to route the requests through API gateways
+	UserAgentSuffix string
+
 	// Do not verify TLS certs for HTTPS requests - useful if you're behind a corporate proxy
 	InsecureTls bool
 
+	// Validate PCL condition expressions at plan time
+	ValidateConditions bool
+
+	// Fail reading resources deleted outside of Terraform instead of
+	// removing them from state
+	StrictMissing bool
+
+	// Timeout of establishing the connections to the API, defaults to
+	// defaultDialTimeout when zero
+	DialTimeout time.Duration
+
+	// Interval between the keep-alive probes of the connections to the API,
+	// defaults to defaultKeepAlive when zero
+	KeepAlive time.Duration
+
+	// ID of the team assigned to the resources supporting teams which don't
+	// configure any
+	DefaultTeam string
+
 	APITokenType *pagerduty.AuthTokenType
 
 	AppOauthScopedTokenParams *persistentconfig.AppOauthScopedTokenParams
 
 	ServiceRegion string
 
+	// Alternate base URLs of the API per resource type, see endpointConfig
+	EndpointOverrides map[string]string
+
 	client      *pagerduty.Client
 	slackClient *pagerduty.Client
+
+	prioritiesMu sync.Mutex
+	priorities   []*pagerduty.Priority
+
+	licensesMu sync.Mutex
+	licenses   []*pagerduty.License
+
+	abilitiesMu sync.Mutex
+	abilities   []string
+
+	responsePlaysMu sync.Mutex
+	responsePlays   []*pagerduty.ResponsePlay
+
+	incidentCustomFieldsMu sync.Mutex
+	incidentCustomFields   []*pagerduty.IncidentCustomField
+
+	referencesMu sync.Mutex
+	references   map[referenceKey]interface{}
+
+	teamScopeMu      sync.Mutex
+	teamScope        []string
+	teamScopeFetched bool
+
+	tokenScopesMu sync.Mutex
+	tokenScopes   []string
+
+	stats clientStats
+
+	deprecations apiDeprecations
+
+	endpointConfigsMu sync.Mutex
+	endpointConfigs   map[string]*Config
+
+	// The Config the provider was configured with, set on the Configs of
+	// endpointConfig, see root
+	parent *Config
 }
 
+const (
+	defaultDialTimeout = 25 * time.Second
+	defaultKeepAlive   = 20 * time.Second
+)
+
 const invalidCreds = `
 
 No valid credentials found for PagerDuty provider.
@@ -59,26 +126,27 @@ Please see https://www.terraform.io/docs/providers/pagerduty/index.html
 for more information on providing credentials for this provider.
 `
 
-// Client returns a PagerDuty client, initializing when necessary.
-func (c *Config) Client() (*pagerduty.Client, error) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	// Return the previously-configured client if available.
-	if c.client != nil {
-		return c.client, nil
+// dialer returns the dialer of the connections to the API.
+func (c *Config) dialer() *net.Dialer {
+	dialer := &net.Dialer{
+		Timeout:   defaultDialTimeout,
+		KeepAlive: defaultKeepAlive,
 	}
-
-	// Validate that the PagerDuty token is set
-	if c.Token == "" && c.APITokenType != nil && *c.APITokenType == pagerduty.AuthTokenTypeAPIToken {
-		return nil, fmt.Errorf(invalidCreds)
+	if c.DialTimeout > 0 {
+		dialer.Timeout = c.DialTimeout
 	}
+	if c.KeepAlive > 0 {
+		dialer.KeepAlive = c.KeepAlive
+	}
+
+	return dialer
+}
 
+// httpTransport returns the transport of the connections to the API, with the
+// dialer and the TLS settings of the provider.
+func (c *Config) httpTransport() *http.Transport {
 	transport := &http.Transport{
-		DialContext: (&net.Dialer{
-			Timeout:   25 * time.Second,
-			KeepAlive: 20 * time.Second,
-		}).DialContext,
+		DialContext: c.dialer().DialContext,
 		TLSClientConfig: &tls.Config{
 			CipherSuites: []uint16{
 				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
@@ -96,8 +164,36 @@ func (c *Config) Client() (*pagerduty.Client, error) {
 		transport.TLSClientConfig.InsecureSkipVerify = true
 	}
 
+	return transport
+}
+
+// apiTransport wraps the transport of the clients with the retries of the
+// network errors, the logging of the requests, their stats and the
+// deprecation notices of their responses.
+func (c *Config) apiTransport(transport http.RoundTripper) http.RoundTripper {
+	retry := newRetryTransport(newStatsTransport(newDeprecationTransport(newRequestIDTransport(logging.NewTransport("PagerDuty", transport)), &c.root().deprecations), &c.root().stats))
+	retry.stats = &c.root().stats
+
+	return retry
+}
+
+// Client returns a PagerDuty client, initializing when necessary.
+func (c *Config) Client() (*pagerduty.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Return the previously-configured client if available.
+	if c.client != nil {
+		return c.client, nil
+	}
+
+	// Validate that the PagerDuty token is set
+	if c.Token == "" && c.APITokenType != nil && *c.APITokenType == pagerduty.AuthTokenTypeAPIToken {
+		return nil, fmt.Errorf(invalidCreds)
+	}
+
 	httpClient := &http.Client{
-		Transport: logging.NewTransport("PagerDuty", transport),
+		Transport: c.apiTransport(c.httpTransport()),
 		Timeout:   2 * time.Minute,
 	}
 
@@ -111,7 +207,7 @@ func (c *Config) Client() (*pagerduty.Client, error) {
 		Debug:                     logging.IsDebugOrHigher(),
 		HTTPClient:                httpClient,
 		Token:                     c.Token,
-		UserAgent:                 c.UserAgent,
+		UserAgent:                 c.userAgent(),
 		AppOauthScopedTokenParams: c.AppOauthScopedTokenParams,
 		APIAuthTokenType:          c.APITokenType,
 	}
@@ -136,6 +232,31 @@ func (c *Config) Client() (*pagerduty.Client, error) {
 	return c.client, nil
 }
 
+// userAgent returns the UserAgent of the API clients, followed by the
+// UserAgentSuffix when set.
+func (c *Config) userAgent() string {
+	if c.UserAgentSuffix == "" {
+		return c.UserAgent
+	}
+
+	return strings.TrimSpace(c.UserAgent + " " + c.UserAgentSuffix)
+}
+
+// appURL returns the URL of the web app of the service region, which the
+// Slack connections are managed through, unless AppUrl overrides it.
+func (c *Config) appURL() string {
+	if c.AppUrl != "" {
+		return c.AppUrl
+	}
+
+	region := strings.ToLower(c.ServiceRegion)
+	if region == "" || region == "us" {
+		return "https://app.pagerduty.com"
+	}
+
+	return "https://app." + region + ".pagerduty.com"
+}
+
 func (c *Config) SlackClient() (*pagerduty.Client, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -157,14 +278,14 @@ func (c *Config) SlackClient() (*pagerduty.Client, error) {
 	if c.InsecureTls {
 		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 	}
-	httpClient.Transport = logging.NewTransport("PagerDuty", transport)
+	httpClient.Transport = c.apiTransport(transport)
 
 	config := &pagerduty.Config{
-		BaseURL:    c.AppUrl,
+		BaseURL:    c.appURL(),
 		Debug:      logging.IsDebugOrHigher(),
 		HTTPClient: httpClient,
 		Token:      c.UserToken,
-		UserAgent:  c.UserAgent,
+		UserAgent:  c.userAgent(),
 	}
 
 	client, err := pagerduty.NewClient(config)
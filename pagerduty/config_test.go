@@ -1,7 +1,14 @@
 package pagerduty
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 // Test config with an empty token
@@ -66,6 +73,83 @@ func TestConfigCustomAppUrl(t *testing.T) {
 	}
 }
 
+// Test the Slack client uses the app URL of the service region
+func TestConfigSlackClientAppUrl(t *testing.T) {
+	cases := []struct {
+		region   string
+		appURL   string
+		expected string
+	}{
+		{"", "", "https://app.pagerduty.com"},
+		{"us", "", "https://app.pagerduty.com"},
+		{"eu", "", "https://app.eu.pagerduty.com"},
+		{"eu", "https://app.domain.tld", "https://app.domain.tld"},
+	}
+
+	for _, c := range cases {
+		config := Config{
+			UserToken:     "foo",
+			ServiceRegion: c.region,
+			AppUrl:        c.appURL,
+		}
+
+		client, err := config.SlackClient()
+		if err != nil {
+			t.Fatalf("error: expected the client to not fail: %v", err)
+		}
+		if client.Config.BaseURL != c.expected {
+			t.Errorf("service region %q and app URL %q: expected %s, got %s", c.region, c.appURL, c.expected, client.Config.BaseURL)
+		}
+	}
+}
+
+// Test the user agent suffix is sent by both clients
+func TestConfigUserAgentSuffix(t *testing.T) {
+	var userAgents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:               "foo",
+		UserToken:           "bar",
+		UserAgent:           "(linux amd64) Terraform/1.5.0",
+		UserAgentSuffix:     "acme-gateway/1.0",
+		ApiUrlOverride:      server.URL,
+		AppUrl:              server.URL,
+		SkipCredsValidation: true,
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("error: expected the client to not fail: %v", err)
+	}
+	slackClient, err := config.SlackClient()
+	if err != nil {
+		t.Fatalf("error: expected the slack client to not fail: %v", err)
+	}
+
+	for _, c := range []*pagerduty.Client{client, slackClient} {
+		var v interface{}
+		if err := getAPIResource(context.Background(), c, "/abilities", nil, &v); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	expected := "(linux amd64) Terraform/1.5.0 acme-gateway/1.0"
+	if len(userAgents) != 2 {
+		t.Fatalf("expected a request of each client, got %d", len(userAgents))
+	}
+	for _, got := range userAgents {
+		if got != expected {
+			t.Errorf("expected the User-Agent %q, got %q", expected, got)
+		}
+	}
+}
+
 // Test config with InsecureTls setting
 func TestConfigInsecureTls(t *testing.T) {
 	config := Config{
@@ -78,3 +162,83 @@ func TestConfigInsecureTls(t *testing.T) {
 		t.Fatalf("error: expected the client to not fail: %v", err)
 	}
 }
+
+// Test the dialer uses the configured dial timeout and keep-alive
+func TestConfigDialer(t *testing.T) {
+	config := Config{}
+	if dialer := config.dialer(); dialer.Timeout != defaultDialTimeout || dialer.KeepAlive != defaultKeepAlive {
+		t.Errorf("expected the default dial timeout and keep-alive, got %s and %s", dialer.Timeout, dialer.KeepAlive)
+	}
+
+	config = Config{
+		DialTimeout: 60 * time.Second,
+		KeepAlive:   45 * time.Second,
+	}
+	if dialer := config.dialer(); dialer.Timeout != 60*time.Second || dialer.KeepAlive != 45*time.Second {
+		t.Errorf("expected the configured dial timeout and keep-alive, got %s and %s", dialer.Timeout, dialer.KeepAlive)
+	}
+}
+
+// Test a canceled context aborts a slow request
+func TestConfigContextCancelation(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	config := Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	r := resourcePagerDutyIncidentWorkflow()
+	d := r.TestResourceData()
+	d.SetId("PABC123")
+
+	start := time.Now()
+	diags := r.ReadContext(ctx, d, &config)
+	if !diags.HasError() {
+		t.Fatalf("expected the read to fail when its context is canceled")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the read to be aborted once its context is canceled, took %s", elapsed)
+	}
+}
+
+// Test the request ID of a failed call is included in its error
+func TestConfigRequestIDInErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "f3a1b2c3d4e5")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"code":2001,"message":"Invalid Input Provided","errors":["Invalid ID"]}}`))
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := resourcePagerDutyIncidentWorkflow()
+	d := r.TestResourceData()
+	d.SetId("PABC123")
+
+	diags := r.ReadContext(context.Background(), d, &config)
+	if !diags.HasError() {
+		t.Fatalf("expected the read to fail")
+	}
+	if summary := diags[0].Summary; !strings.Contains(summary, "request ID: f3a1b2c3d4e5") {
+		t.Errorf("expected the error to include the request ID, got %q", summary)
+	}
+}
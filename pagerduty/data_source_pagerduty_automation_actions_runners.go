@@ -0,0 +1,151 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
+)
+
+// automationActionsRunnersPageLimit is the maximum number of runners the API
+// returns in a page.
+const automationActionsRunnersPageLimit = 100
+
+func dataSourcePagerDutyAutomationActionsRunners() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyAutomationActionsRunnersRead,
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of a team to only return the runners associated with it",
+			},
+			"runners": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Automation Actions runners of the account",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"runner_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type listAutomationActionsRunnersResponse struct {
+	Runners    []*pagerduty.AutomationActionsRunner `json:"runners"`
+	NextCursor *string                              `json:"next_cursor"`
+}
+
+func dataSourcePagerDutyAutomationActionsRunnersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	teamID := d.Get("team_id").(string)
+
+	log.Printf("[INFO] Reading PagerDuty automation actions runners")
+
+	var runners []*pagerduty.AutomationActionsRunner
+	retryErr := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		var err error
+		runners, err = listAllAutomationActionsRunners(ctx, client)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			util.SleepContext(ctx, 30*time.Second)
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		return diag.FromErr(retryErr)
+	}
+
+	found := []map[string]interface{}{}
+	for _, runner := range runners {
+		if teamID != "" && !isAutomationActionsRunnerOfTeam(runner, teamID) {
+			continue
+		}
+		found = append(found, map[string]interface{}{
+			"id":          runner.ID,
+			"name":        runner.Name,
+			"runner_type": runner.RunnerType,
+		})
+	}
+
+	// Since this data doesn't have an unique ID, this forces the data to be
+	// refreshed with each Terraform apply.
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	if err := d.Set("runners", found); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// listAllAutomationActionsRunners follows the cursors of the list of runners
+// until its last page, the API client has no method for the endpoint.
+func listAllAutomationActionsRunners(ctx context.Context, client *pagerduty.Client) ([]*pagerduty.AutomationActionsRunner, error) {
+	var runners []*pagerduty.AutomationActionsRunner
+
+	cursor := ""
+	for {
+		query := url.Values{}
+		query.Set("limit", strconv.Itoa(automationActionsRunnersPageLimit))
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+
+		var resp listAutomationActionsRunnersResponse
+		if err := getAPIResource(ctx, client, "/automation_actions/runners", query, &resp); err != nil {
+			return nil, err
+		}
+		runners = append(runners, resp.Runners...)
+
+		if resp.NextCursor == nil || *resp.NextCursor == "" {
+			return runners, nil
+		}
+		if *resp.NextCursor == cursor {
+			return nil, fmt.Errorf("the list of automation actions runners returned the same cursor %q twice", cursor)
+		}
+		cursor = *resp.NextCursor
+	}
+}
+
+func isAutomationActionsRunnerOfTeam(runner *pagerduty.AutomationActionsRunner, teamID string) bool {
+	for _, t := range runner.Teams {
+		if t != nil && t.ID == teamID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDataSourcePagerDutyAutomationActionsRunners(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/automation_actions/runners" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got := r.URL.Query().Get("limit"); got != "100" {
+			t.Errorf("expected the runners to be listed 100 at a time, got a limit of %q", got)
+		}
+
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Write([]byte(`{"runners":[
+				{"id":"01RUNNER1","name":"sidecar","runner_type":"sidecar","teams":[{"id":"PTEAM01","type":"team_reference"}]},
+				{"id":"01RUNNER2","name":"runbook","runner_type":"runbook"}
+			],"next_cursor":"page2"}`))
+		case "page2":
+			w.Write([]byte(`{"runners":[
+				{"id":"01RUNNER3","name":"other sidecar","runner_type":"sidecar","teams":[{"id":"PTEAM02","type":"team_reference"},{"id":"PTEAM01","type":"team_reference"}]}
+			],"next_cursor":null}`))
+		default:
+			t.Errorf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	cases := []struct {
+		teamID   string
+		expected []string
+	}{
+		{expected: []string{"01RUNNER1", "01RUNNER2", "01RUNNER3"}},
+		{teamID: "PTEAM01", expected: []string{"01RUNNER1", "01RUNNER3"}},
+		{teamID: "PTEAM03", expected: []string{}},
+	}
+	for _, c := range cases {
+		r := dataSourcePagerDutyAutomationActionsRunners()
+		d := r.TestResourceData()
+		d.Set("team_id", c.teamID)
+		if diags := r.ReadContext(context.Background(), d, config); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		runners := d.Get("runners").([]interface{})
+		if len(runners) != len(c.expected) {
+			t.Fatalf("expected %d runners for team %q, got %d", len(c.expected), c.teamID, len(runners))
+		}
+		for i, id := range c.expected {
+			if got := runners[i].(map[string]interface{})["id"]; got != id {
+				t.Errorf("expected runner %d of team %q to be %s, got %v", i, c.teamID, id, got)
+			}
+		}
+	}
+
+	r := dataSourcePagerDutyAutomationActionsRunners()
+	d := r.TestResourceData()
+	if diags := r.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	runner := d.Get("runners").([]interface{})[1].(map[string]interface{})
+	if runner["name"] != "runbook" || runner["runner_type"] != "runbook" {
+		t.Errorf("expected the name and runner_type of the runners to be set, got %v", runner)
+	}
+}
@@ -0,0 +1,54 @@
+package pagerduty
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourcePagerDutyClientStats() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyClientStatsRead,
+
+		Schema: map[string]*schema.Schema{
+			"total_requests": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of requests made to the PagerDuty API so far, counting each of their attempts",
+			},
+			"retries": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of requests retried because of network errors or rate limits",
+			},
+			"rate_limited": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of requests rejected by the rate limits of the PagerDuty API",
+			},
+			"total_wait_seconds": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The time spent waiting before retrying the requests",
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyClientStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	stats := &meta.(*Config).root().stats
+
+	log.Printf("[INFO] Reading PagerDuty client stats")
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("total_requests", stats.totalRequests())
+	d.Set("retries", stats.totalRetries())
+	d.Set("rate_limited", stats.totalRateLimited())
+	d.Set("total_wait_seconds", stats.totalWait().Seconds())
+
+	return nil
+}
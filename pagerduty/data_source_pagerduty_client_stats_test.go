@@ -0,0 +1,95 @@
+package pagerduty
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestDataSourcePagerDutyClientStats(t *testing.T) {
+	var calls int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt64(&calls, 1) == 1 {
+			w.Header().Set("ratelimit-reset", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"error":{"code":2020,"message":"Rate Limit Exceeded"}}`))
+			return
+		}
+		w.Write([]byte(`{"user":{"id":"PABC123","name":"foo"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := client.Users.Get("PABC123", &pagerduty.GetUserOptions{}); err != nil {
+		t.Fatalf("expected the rate limited request to succeed on retry: %s", err)
+	}
+
+	r := dataSourcePagerDutyClientStats()
+	d := r.TestResourceData()
+	if diags := r.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]int{
+		"total_requests": 2,
+		"retries":        1,
+		"rate_limited":   1,
+	}
+	for k, v := range expected {
+		if got := d.Get(k).(int); got != v {
+			t.Errorf("expected %s to be %d, got %d", k, v, got)
+		}
+	}
+	if got := d.Get("total_wait_seconds").(float64); got != 0 {
+		t.Errorf("expected total_wait_seconds to be the ratelimit-reset of the response, got %v", got)
+	}
+}
+
+func TestClientStatsNetworkRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	stats := &clientStats{}
+	attempts := 0
+	transport := newRetryTransport(newStatsTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	}), stats))
+	transport.maxDelay = time.Millisecond
+	transport.stats = stats
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("expected request to succeed on retry: %s", err)
+	}
+	resp.Body.Close()
+
+	if stats.totalRequests() != 2 || stats.totalRetries() != 1 || stats.totalRateLimited() != 0 {
+		t.Errorf("expected 2 requests, 1 retry and no rate limits, got %d, %d and %d", stats.totalRequests(), stats.totalRetries(), stats.totalRateLimited())
+	}
+	if stats.totalWait() != time.Millisecond {
+		t.Errorf("expected the wait to be the delay of the retry, got %s", stats.totalWait())
+	}
+}
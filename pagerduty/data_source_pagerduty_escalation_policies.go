@@ -0,0 +1,139 @@
+package pagerduty
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyEscalationPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyEscalationPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"schedule_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of a schedule to only return the escalation policies with rules targeting it",
+			},
+			"escalation_policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of escalation policies queried",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyEscalationPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	c := meta.(*Config)
+	client, err := c.Client()
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading PagerDuty escalation policies")
+
+	// The tokens scoped to teams only list the escalation policies of their
+	// teams.
+	teamIDs, err := c.scopedTeamIDs(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+
+	found, err := listAllEscalationPolicies(client, teamIDs)
+	if err != nil {
+		return c.explainTeamScopeError(context.Background(), err, "Listing the escalation policies")
+	}
+
+	scheduleID := d.Get("schedule_id").(string)
+
+	policies := []map[string]interface{}{}
+	for _, ep := range found {
+		if scheduleID != "" && !isEscalationPolicyTargetingSchedule(ep, scheduleID) {
+			continue
+		}
+		policies = append(policies, map[string]interface{}{
+			"id":   ep.ID,
+			"name": ep.Name,
+		})
+	}
+
+	// Since this data doesn't have an unique ID, this force this data to be
+	// refreshed in every Terraform apply
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("escalation_policies", policies)
+
+	return nil
+}
+
+// listAllEscalationPolicies goes through all the pages of the escalation
+// policies of the teams given, or of the account without teams, the API client
+// only lists one page at a time.
+func listAllEscalationPolicies(client *pagerduty.Client, teamIDs []string) ([]*pagerduty.EscalationPolicy, error) {
+	var policies []*pagerduty.EscalationPolicy
+
+	o := &pagerduty.ListEscalationPoliciesOptions{Limit: 100, TeamIDs: teamIDs}
+	for {
+		var resp *pagerduty.ListEscalationPoliciesResponse
+		err := retry.Retry(5*time.Minute, func() *retry.RetryError {
+			var err error
+			resp, _, err = client.EscalationPolicies.List(o)
+			if err != nil {
+				if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusForbidden) {
+					return retry.NonRetryableError(err)
+				}
+
+				// Delaying retry by 30s as recommended by PagerDuty
+				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+				time.Sleep(30 * time.Second)
+				return retry.RetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, resp.EscalationPolicies...)
+		if !resp.More || len(resp.EscalationPolicies) == 0 {
+			return policies, nil
+		}
+		o.Offset += len(resp.EscalationPolicies)
+	}
+}
+
+func isEscalationPolicyTargetingSchedule(ep *pagerduty.EscalationPolicy, scheduleID string) bool {
+	for _, rule := range ep.EscalationRules {
+		if rule == nil {
+			continue
+		}
+		for _, target := range rule.Targets {
+			if target == nil || target.ID != scheduleID {
+				continue
+			}
+			if target.Type == "schedule_reference" || target.Type == "schedule" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,179 @@
+package pagerduty
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyEscalationPolicies_ScheduleID(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	referencing := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	notReferencing := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	start := timeNowInLoc("America/New_York").Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyEscalationPoliciesConfig(username, email, schedule, referencing, notReferencing, start),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_escalation_policies.by_schedule", "escalation_policies.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_escalation_policies.by_schedule", "escalation_policies.0.id",
+						"pagerduty_escalation_policy.referencing", "id"),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_escalation_policies.by_schedule", "escalation_policies.0.name", referencing),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_escalation_policies.by_unused_schedule", "escalation_policies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourcePagerDutyEscalationPolicies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/escalation_policies" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.URL.Query().Get("offset") {
+		case "":
+			w.Write([]byte(`{"escalation_policies":[
+				{"id":"PEP0001","name":"schedule first","escalation_rules":[{"targets":[{"id":"PSCHED1","type":"schedule_reference"}]}]},
+				{"id":"PEP0002","name":"user only","escalation_rules":[{"targets":[{"id":"PSCHED1","type":"user_reference"}]}]}
+			],"limit":2,"offset":0,"more":true}`))
+		case "2":
+			w.Write([]byte(`{"escalation_policies":[
+				{"id":"PEP0003","name":"schedule second","escalation_rules":[{"targets":[{"id":"PUSER01","type":"user_reference"}]},{"targets":[{"id":"PSCHED1","type":"schedule_reference"}]}]}
+			],"limit":2,"offset":2,"more":false}`))
+		default:
+			t.Errorf("unexpected offset %q", r.URL.Query().Get("offset"))
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	cases := []struct {
+		scheduleID string
+		expected   []string
+	}{
+		{expected: []string{"PEP0001", "PEP0002", "PEP0003"}},
+		{scheduleID: "PSCHED1", expected: []string{"PEP0001", "PEP0003"}},
+		{scheduleID: "PSCHED2", expected: []string{}},
+	}
+	for _, c := range cases {
+		r := dataSourcePagerDutyEscalationPolicies()
+		d := r.TestResourceData()
+		d.Set("schedule_id", c.scheduleID)
+		if err := dataSourcePagerDutyEscalationPoliciesRead(d, config); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		policies := d.Get("escalation_policies").([]interface{})
+		if len(policies) != len(c.expected) {
+			t.Fatalf("expected %d escalation policies for schedule %q, got %d", len(c.expected), c.scheduleID, len(policies))
+		}
+		for i, id := range c.expected {
+			if got := policies[i].(map[string]interface{})["id"]; got != id {
+				t.Errorf("expected escalation policy %d of schedule %q to be %s, got %v", i, c.scheduleID, id, got)
+			}
+		}
+	}
+}
+
+func testAccDataSourcePagerDutyEscalationPoliciesConfig(username, email, schedule, referencing, notReferencing, start string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]s"
+  email = "%[2]s"
+}
+
+resource "pagerduty_schedule" "foo" {
+  name      = "%[3]s"
+  time_zone = "America/New_York"
+
+  layer {
+    name                         = "foo"
+    start                        = "%[6]s"
+    rotation_virtual_start       = "%[6]s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.foo.id]
+  }
+}
+
+resource "pagerduty_schedule" "unused" {
+  name      = "%[3]s-unused"
+  time_zone = "America/New_York"
+
+  layer {
+    name                         = "foo"
+    start                        = "%[6]s"
+    rotation_virtual_start       = "%[6]s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.foo.id]
+  }
+}
+
+resource "pagerduty_escalation_policy" "referencing" {
+  name = "%[4]s"
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "schedule_reference"
+      id   = pagerduty_schedule.foo.id
+    }
+  }
+}
+
+resource "pagerduty_escalation_policy" "not_referencing" {
+  name = "%[5]s"
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+data "pagerduty_escalation_policies" "by_schedule" {
+  schedule_id = pagerduty_schedule.foo.id
+
+  depends_on = [
+    pagerduty_escalation_policy.referencing,
+    pagerduty_escalation_policy.not_referencing,
+  ]
+}
+
+data "pagerduty_escalation_policies" "by_unused_schedule" {
+  schedule_id = pagerduty_schedule.unused.id
+
+  depends_on = [
+    pagerduty_escalation_policy.referencing,
+    pagerduty_escalation_policy.not_referencing,
+  ]
+}
+`, username, email, schedule, referencing, notReferencing, start)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 func dataSourcePagerDutyEventOrchestrationIntegration() *schema.Resource {
@@ -35,8 +36,9 @@ func dataSourcePagerDutyEventOrchestrationIntegration() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"routing_key": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
 						},
 						"type": {
 							Type:     schema.TypeString,
@@ -90,7 +92,7 @@ func getEventOrchestrationIntegrationById(ctx context.Context, d *schema.Resourc
 				return retry.NonRetryableError(err)
 			}
 
-			time.Sleep(30 * time.Second)
+			util.SleepContext(ctx, 30*time.Second)
 			return retry.RetryableError(err)
 		} else if integration != nil {
 			d.SetId(integration.ID)
@@ -125,7 +127,7 @@ func getEventOrchestrationIntegrationByLabel(ctx context.Context, d *schema.Reso
 				return retry.NonRetryableError(err)
 			}
 
-			time.Sleep(30 * time.Second)
+			util.SleepContext(ctx, 30*time.Second)
 			return retry.RetryableError(err)
 		}
 
@@ -54,8 +54,9 @@ func dataSourcePagerDutyEventOrchestrations() *schema.Resource {
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"routing_key": {
-													Type:     schema.TypeString,
-													Computed: true,
+													Type:      schema.TypeString,
+													Computed:  true,
+													Sensitive: true,
 												},
 												"type": {
 													Type:     schema.TypeString,
@@ -0,0 +1,221 @@
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
+)
+
+func dataSourcePagerDutyIncident() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyIncidentRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"incident_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"urgency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"escalation_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"team_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"custom_fields": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"field_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// incidentCustomFieldValue is a custom field of an incident along with its
+// value, as returned by the custom field values endpoint of the incidents.
+type incidentCustomFieldValue struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	DisplayName string          `json:"display_name"`
+	DataType    string          `json:"data_type"`
+	FieldType   string          `json:"field_type"`
+	Value       json.RawMessage `json:"value"`
+}
+
+func dataSourcePagerDutyIncidentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := d.Get("id").(string)
+	log.Printf("[INFO] Reading PagerDuty incident %s", id)
+
+	err = retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		incident, _, err := client.Incidents.Get(id)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(err)
+			}
+
+			// Delaying retry by 30s as recommended by PagerDuty
+			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+			util.SleepContext(ctx, 30*time.Second)
+			return retry.RetryableError(err)
+		}
+
+		fields, err := fetchIncidentCustomFieldValues(ctx, client, id)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(err)
+			}
+
+			util.SleepContext(ctx, 30*time.Second)
+			return retry.RetryableError(err)
+		}
+
+		d.SetId(incident.ID)
+		d.Set("incident_number", incident.IncidentNumber)
+		d.Set("title", incident.Title)
+		d.Set("status", incident.Status)
+		d.Set("urgency", incident.Urgency)
+		d.Set("html_url", incident.HTMLURL)
+		d.Set("created_at", incident.CreatedAt)
+
+		if incident.Service != nil {
+			d.Set("service_id", incident.Service.ID)
+		}
+		if incident.EscalationPolicy != nil {
+			d.Set("escalation_policy_id", incident.EscalationPolicy.ID)
+		}
+
+		teamIDs := []string{}
+		for _, t := range incident.Teams {
+			teamIDs = append(teamIDs, t.ID)
+		}
+		d.Set("team_ids", teamIDs)
+
+		if err := d.Set("custom_fields", flattenIncidentCustomFieldValues(fields)); err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// fetchIncidentCustomFieldValues gets the values of the custom fields of an
+// incident, the API client has no method for the endpoint.
+func fetchIncidentCustomFieldValues(ctx context.Context, client *pagerduty.Client, id string) ([]incidentCustomFieldValue, error) {
+	var v struct {
+		CustomFields []incidentCustomFieldValue `json:"custom_fields"`
+	}
+	if err := getAPIResource(ctx, client, fmt.Sprintf("/incidents/%s/custom_fields/values", id), nil, &v); err != nil {
+		return nil, err
+	}
+
+	return v.CustomFields, nil
+}
+
+// flattenIncidentCustomFieldValues sets the values of the text fields as they
+// are, the values of other data types and of the multi value fields are set
+// JSON encoded, and the fields without a value get an empty value.
+func flattenIncidentCustomFieldValues(fields []incidentCustomFieldValue) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(fields))
+	for _, f := range fields {
+		value := ""
+		if len(f.Value) > 0 && string(f.Value) != "null" {
+			var s string
+			if err := json.Unmarshal(f.Value, &s); err == nil {
+				value = s
+			} else {
+				var buf bytes.Buffer
+				if err := json.Compact(&buf, f.Value); err == nil {
+					value = buf.String()
+				}
+			}
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":           f.ID,
+			"name":         f.Name,
+			"display_name": f.DisplayName,
+			"data_type":    f.DataType,
+			"field_type":   f.FieldType,
+			"value":        value,
+		})
+	}
+
+	return result
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 func dataSourcePagerDutyIncidentCustomField() *schema.Resource {
@@ -60,7 +61,7 @@ func dataSourcePagerDutyIncidentCustomFieldRead(ctx context.Context, d *schema.R
 
 			// Delaying retry by 30s as recommended by PagerDuty
 			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
-			time.Sleep(30 * time.Second)
+			util.SleepContext(ctx, 30*time.Second)
 			return retry.RetryableError(err)
 		}
 
@@ -0,0 +1,112 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDataSourcePagerDutyIncident(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if got := r.Header.Get("Authorization"); got != "Token token=foo" {
+			t.Errorf("expected the requests to be authenticated with the API token, got %q", got)
+		}
+
+		switch r.URL.Path {
+		case "/incidents/PINC123":
+			w.Write([]byte(`{"incident":{
+				"id":"PINC123",
+				"incident_number":42,
+				"title":"Disk full on db-1",
+				"status":"triggered",
+				"urgency":"high",
+				"html_url":"https://acme.pagerduty.com/incidents/PINC123",
+				"created_at":"2024-01-02T03:04:05Z",
+				"service":{"id":"PSVC123","type":"service_reference"},
+				"escalation_policy":{"id":"PEP1234","type":"escalation_policy_reference"},
+				"teams":[{"id":"PTEAM12","type":"team_reference"}]
+			}}`))
+		case "/incidents/PINC123/custom_fields/values":
+			w.Write([]byte(`{"custom_fields":[
+				{"id":"PCF0001","name":"environment","display_name":"Environment","data_type":"string","field_type":"single_value","value":"production"},
+				{"id":"PCF0002","name":"impacted_hosts","display_name":"Impacted hosts","data_type":"integer","field_type":"single_value","value":3},
+				{"id":"PCF0003","name":"regions","display_name":"Regions","data_type":"string","field_type":"multi_value_fixed","value":["us-east-1", "eu-west-1"]},
+				{"id":"PCF0004","name":"customer_facing","display_name":"Customer facing","data_type":"boolean","field_type":"single_value","value":null}
+			]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := dataSourcePagerDutyIncident()
+	d := r.TestResourceData()
+	d.Set("id", "PINC123")
+	if diags := r.ReadContext(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]string{
+		"id":                           "PINC123",
+		"incident_number":              "42",
+		"title":                        "Disk full on db-1",
+		"status":                       "triggered",
+		"urgency":                      "high",
+		"service_id":                   "PSVC123",
+		"escalation_policy_id":         "PEP1234",
+		"team_ids.#":                   "1",
+		"team_ids.0":                   "PTEAM12",
+		"custom_fields.#":              "4",
+		"custom_fields.0.name":         "environment",
+		"custom_fields.0.display_name": "Environment",
+		"custom_fields.0.data_type":    "string",
+		"custom_fields.0.value":        "production",
+		"custom_fields.1.value":        "3",
+		"custom_fields.2.field_type":   "multi_value_fixed",
+		"custom_fields.2.value":        `["us-east-1","eu-west-1"]`,
+		"custom_fields.3.id":           "PCF0004",
+		"custom_fields.3.value":        "",
+	}
+	state := d.State()
+	for k, v := range expected {
+		if got := state.Attributes[k]; got != v {
+			t.Errorf("expected %s to be %q, got %q", k, v, got)
+		}
+	}
+}
+
+func TestDataSourcePagerDutyIncident_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := dataSourcePagerDutyIncident()
+	d := r.TestResourceData()
+	d.Set("id", "PNOPE12")
+	diags := r.ReadContext(context.Background(), d, config)
+	if !diags.HasError() {
+		t.Fatal("expected an error reading a missing incident")
+	}
+	if !strings.Contains(diags[0].Summary, "404") {
+		t.Errorf("expected the error to report the status of the response, got %q", diags[0].Summary)
+	}
+}
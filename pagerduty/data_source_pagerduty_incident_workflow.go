@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 func dataSourcePagerDutyIncidentWorkflow() *schema.Resource {
@@ -26,6 +27,13 @@ func dataSourcePagerDutyIncidentWorkflow() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"step_action_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -39,6 +47,7 @@ func dataSourcePagerDutyIncidentWorkflowRead(ctx context.Context, d *schema.Reso
 	log.Printf("[INFO] Reading PagerDuty incident workflow")
 
 	searchName := d.Get("name").(string)
+	var id string
 
 	err = retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
 		resp, _, err := client.IncidentWorkflows.ListContext(ctx, &pagerduty.ListIncidentWorkflowOptions{})
@@ -49,30 +58,63 @@ func dataSourcePagerDutyIncidentWorkflowRead(ctx context.Context, d *schema.Reso
 
 			// Delaying retry by 30s as recommended by PagerDuty
 			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
-			time.Sleep(30 * time.Second)
+			util.SleepContext(ctx, 30*time.Second)
 			return retry.RetryableError(err)
 		}
 
-		var found *pagerduty.IncidentWorkflow
+		var found []*pagerduty.IncidentWorkflow
 
 		for _, iw := range resp.IncidentWorkflows {
 			if iw.Name == searchName {
-				found = iw
-				break
+				found = append(found, iw)
 			}
 		}
 
-		if found == nil {
+		if len(found) == 0 {
 			return retry.NonRetryableError(
 				fmt.Errorf("unable to locate any incident workflow with name: %s", searchName),
 			)
 		}
+		if len(found) > 1 {
+			return retry.NonRetryableError(
+				fmt.Errorf("found %d incident workflows with name: %s, please make the name unique to look it up", len(found), searchName),
+			)
+		}
+
+		id = found[0].ID
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-		err = flattenIncidentWorkflow(d, found, false, nil, false)
+	// Steps are only included when getting a single incident workflow.
+	err = retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		iw, _, err := client.IncidentWorkflows.GetContext(ctx, id)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+
+			util.SleepContext(ctx, 30*time.Second)
+			return retry.RetryableError(err)
+		}
+
+		// Without the provider config the team is reported even when it's
+		// the default team of the provider.
+		err = flattenIncidentWorkflow(d, iw, false, nil, false, nil)
 		if err != nil {
 			return retry.NonRetryableError(err)
 		}
 
+		actionIDs := make([]string, 0, len(iw.Steps))
+		for _, s := range iw.Steps {
+			if s.Configuration != nil {
+				actionIDs = append(actionIDs, s.Configuration.ActionID)
+			}
+		}
+		d.Set("step_action_ids", actionIDs)
+
 		return nil
 	})
 
@@ -25,6 +25,8 @@ func TestAccDataSourcePagerDutyIncidentWorkflow(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
 					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "step_action_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "step_action_ids.0", "pagerduty.com:incident-workflows:send-status-update:1"),
 				),
 			},
 		},
@@ -35,6 +37,14 @@ func testAccDataSourcePagerDutyIncidentWorkflowConfig(name string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_incident_workflow" "input" {
   name = "%[1]s"
+  step {
+    name   = "Example Step"
+    action = "pagerduty.com:incident-workflows:send-status-update:1"
+    input {
+      name  = "Message"
+      value = "first update"
+    }
+  }
 }
 
 data "pagerduty_incident_workflow" "%[1]s" {
@@ -0,0 +1,125 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
+)
+
+// resourceExistsGetters fetches an entity of each of the types supported by
+// the pagerduty_resource_exists data source.
+var resourceExistsGetters = map[string]func(client *pagerduty.Client, id string) error{
+	"escalation_policy": func(client *pagerduty.Client, id string) error {
+		_, _, err := client.EscalationPolicies.Get(id, &pagerduty.GetEscalationPolicyOptions{})
+		return err
+	},
+	"schedule": func(client *pagerduty.Client, id string) error {
+		_, _, err := client.Schedules.Get(id, &pagerduty.GetScheduleOptions{})
+		return err
+	},
+	"service": func(client *pagerduty.Client, id string) error {
+		_, _, err := client.Services.Get(id, &pagerduty.GetServiceOptions{})
+		return err
+	},
+	"team": func(client *pagerduty.Client, id string) error {
+		_, _, err := client.Teams.Get(id)
+		return err
+	},
+	"user": func(client *pagerduty.Client, id string) error {
+		_, _, err := client.Users.Get(id, &pagerduty.GetUserOptions{})
+		return err
+	},
+}
+
+func dataSourcePagerDutyResourceExists() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyResourceExistsRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The type of the PagerDuty entity to look for",
+				ValidateDiagFunc: validateValueDiagFunc([]string{
+					"escalation_policy",
+					"schedule",
+					"service",
+					"team",
+					"user",
+				}),
+			},
+			"id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the PagerDuty entity to look for",
+			},
+			"allow_missing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to set exists to false instead of failing when the entity doesn't exist",
+			},
+			"exists": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the entity exists",
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyResourceExistsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entityType := d.Get("type").(string)
+	id := d.Get("id").(string)
+
+	log.Printf("[INFO] Checking PagerDuty %s %s exists", entityType, id)
+
+	get := resourceExistsGetters[entityType]
+	exists := true
+	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		if err := get(client, id); err != nil {
+			if isErrCode(err, http.StatusNotFound) || isMalformedNotFoundError(err) {
+				exists = false
+				return nil
+			}
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+
+			util.SleepContext(ctx, 2*time.Second)
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		return diag.FromErr(retryErr)
+	}
+
+	if !exists && !d.Get("allow_missing").(bool) {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("PagerDuty %s %s doesn't exist", entityType, id),
+				Detail:   fmt.Sprintf("No %s with the ID %q was found in the PagerDuty account, check it isn't mistyped or a reference to an entity of another account. Set allow_missing = true to get exists = false instead of this error.", entityType, id),
+			},
+		}
+	}
+
+	d.SetId(id)
+	d.Set("exists", exists)
+
+	return nil
+}
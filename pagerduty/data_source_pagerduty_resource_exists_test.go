@@ -0,0 +1,63 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyResourceExists_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyResourceExistsConfig(username, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_resource_exists.user", "exists", "true"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_resource_exists.user", "id", "pagerduty_user.test", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_resource_exists.missing", "exists", "false"),
+				),
+			},
+			{
+				Config:      testAccDataSourcePagerDutyResourceExistsMissingConfig(),
+				ExpectError: regexp.MustCompile("PagerDuty service PNOTEXIST doesn't exist"),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyResourceExistsConfig(username, email string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%s"
+  email = "%s"
+}
+
+data "pagerduty_resource_exists" "user" {
+  type = "user"
+  id   = pagerduty_user.test.id
+}
+
+data "pagerduty_resource_exists" "missing" {
+  type          = "team"
+  id            = "PNOTEXIST"
+  allow_missing = true
+}
+`, username, email)
+}
+
+func testAccDataSourcePagerDutyResourceExistsMissingConfig() string {
+	return `
+data "pagerduty_resource_exists" "missing" {
+  type = "service"
+  id   = "PNOTEXIST"
+}
+`
+}
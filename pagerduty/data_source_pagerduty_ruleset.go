@@ -21,8 +21,9 @@ func dataSourcePagerDutyRuleset() *schema.Resource {
 				Required: true,
 			},
 			"routing_keys": {
-				Type:     schema.TypeList,
-				Computed: true,
+				Type:      schema.TypeList,
+				Computed:  true,
+				Sensitive: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -20,12 +20,42 @@ func dataSourcePagerDutySchedule() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The unique IDs of the users of the layers of the schedule",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"layer": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The layers of the schedule which haven't ended",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rendered_coverage_percentage": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func dataSourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	c := meta.(*Config)
+	client, err := c.Client()
 	if err != nil {
 		return err
 	}
@@ -39,36 +69,76 @@ func dataSourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	return retry.Retry(5*time.Minute, func() *retry.RetryError {
-		resp, _, err := client.Schedules.List(o)
+		var id string
+		if cached, ok := c.cachedReference("pagerduty_schedule", searchName); ok {
+			id = cached.(string)
+		} else {
+			resp, _, err := client.Schedules.List(o)
+			if err != nil {
+				if isErrCode(err, http.StatusBadRequest) {
+					return retry.NonRetryableError(err)
+				}
+
+				// Delaying retry by 30s as recommended by PagerDuty
+				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+				time.Sleep(30 * time.Second)
+				return retry.RetryableError(err)
+			}
+
+			for _, schedule := range resp.Schedules {
+				if schedule.Name == searchName {
+					id = schedule.ID
+					break
+				}
+			}
+
+			if id == "" {
+				return retry.NonRetryableError(
+					fmt.Errorf("Unable to locate any schedule with the name: %s", searchName),
+				)
+			}
+			c.cacheReference("pagerduty_schedule", searchName, id)
+		}
+
+		// The layers of the schedules aren't included in the list response.
+		schedule, _, err := client.Schedules.Get(id, scheduleRenderOptions(time.Now()))
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
 			}
 
-			// Delaying retry by 30s as recommended by PagerDuty
-			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
 			time.Sleep(30 * time.Second)
 			return retry.RetryableError(err)
 		}
 
-		var found *pagerduty.Schedule
-
-		for _, schedule := range resp.Schedules {
-			if schedule.Name == searchName {
-				found = schedule
-				break
-			}
+		layers, err := flattenScheduleLayers(schedule.ScheduleLayers, nil)
+		if err != nil {
+			return retry.NonRetryableError(err)
 		}
 
-		if found == nil {
-			return retry.NonRetryableError(
-				fmt.Errorf("Unable to locate any schedule with the name: %s", searchName),
-			)
+		d.SetId(id)
+		d.Set("name", searchName)
+		if err := d.Set("users", flattenScheduleLayersUsers(layers)); err != nil {
+			return retry.NonRetryableError(fmt.Errorf("error setting users: %s", err))
+		}
+		if err := d.Set("layer", flattenScheduleLayersCoverage(layers)); err != nil {
+			return retry.NonRetryableError(fmt.Errorf("error setting layer: %s", err))
 		}
-
-		d.SetId(found.ID)
-		d.Set("name", found.Name)
 
 		return nil
 	})
 }
+
+// flattenScheduleLayersCoverage keeps the identification and the rendered
+// coverage of the flattened layers of a schedule.
+func flattenScheduleLayersCoverage(layers []map[string]interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(layers))
+	for _, l := range layers {
+		result = append(result, map[string]interface{}{
+			"id":                           l["id"],
+			"name":                         l["name"],
+			"rendered_coverage_percentage": l["rendered_coverage_percentage"],
+		})
+	}
+	return result
+}
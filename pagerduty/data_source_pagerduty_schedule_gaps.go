@@ -0,0 +1,156 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyScheduleGaps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyScheduleGapsRead,
+
+		Schema: map[string]*schema.Schema{
+			"schedule_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"since": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRFC3339,
+				Description:  "The start of the time window to look for gaps in, in RFC 3339 format",
+			},
+			"until": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRFC3339,
+				Description:  "The end of the time window to look for gaps in, in RFC 3339 format",
+			},
+			"gaps": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The time ranges of the window without anyone on call in the final schedule",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyScheduleGapsRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	scheduleID := d.Get("schedule_id").(string)
+	since, _ := time.Parse(time.RFC3339, d.Get("since").(string))
+	until, _ := time.Parse(time.RFC3339, d.Get("until").(string))
+	if !until.After(since) {
+		return fmt.Errorf("until (%s) must be after since (%s)", d.Get("until"), d.Get("since"))
+	}
+
+	log.Printf("[INFO] Reading PagerDuty schedule %s gaps", scheduleID)
+
+	o := &pagerduty.GetScheduleOptions{
+		Since: since.Format(time.RFC3339),
+		Until: until.Format(time.RFC3339),
+	}
+
+	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+		schedule, _, err := client.Schedules.Get(scheduleID, o)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(err)
+			}
+
+			time.Sleep(30 * time.Second)
+			return retry.RetryableError(err)
+		}
+
+		var entries []*pagerduty.ScheduleLayerEntry
+		if schedule.FinalSchedule != nil {
+			entries = schedule.FinalSchedule.RenderedScheduleEntries
+		}
+
+		gaps, err := computeScheduleGaps(entries, since, until)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		d.SetId(fmt.Sprintf("%s:%s:%s", scheduleID, o.Since, o.Until))
+		if err := d.Set("gaps", gaps); err != nil {
+			return retry.NonRetryableError(fmt.Errorf("error setting gaps: %s", err))
+		}
+
+		return nil
+	})
+}
+
+// computeScheduleGaps returns the time ranges between since and until not
+// covered by any of the rendered entries of a schedule, in the time zone of
+// since.
+func computeScheduleGaps(entries []*pagerduty.ScheduleLayerEntry, since, until time.Time) ([]map[string]interface{}, error) {
+	type span struct{ start, end time.Time }
+
+	spans := make([]span, 0, len(entries))
+	for _, e := range entries {
+		start, err := time.Parse(time.RFC3339, e.Start)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing the start of a schedule entry: %s", err)
+		}
+		end, err := time.Parse(time.RFC3339, e.End)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing the end of a schedule entry: %s", err)
+		}
+		spans = append(spans, span{start, end})
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start.Before(spans[j].start) })
+
+	gaps := []map[string]interface{}{}
+	addGap := func(start, end time.Time) {
+		gaps = append(gaps, map[string]interface{}{
+			"start": start.In(since.Location()).Format(time.RFC3339),
+			"end":   end.In(since.Location()).Format(time.RFC3339),
+		})
+	}
+
+	covered := since
+	for _, s := range spans {
+		if !s.end.After(covered) {
+			continue
+		}
+		if s.start.After(covered) {
+			if !s.start.Before(until) {
+				break
+			}
+			addGap(covered, s.start)
+		}
+		covered = s.end
+		if !covered.Before(until) {
+			return gaps, nil
+		}
+	}
+	if covered.Before(until) {
+		addGap(covered, until)
+	}
+
+	return gaps, nil
+}
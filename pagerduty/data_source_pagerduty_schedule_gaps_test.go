@@ -0,0 +1,120 @@
+package pagerduty
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestComputeScheduleGaps(t *testing.T) {
+	since, _ := time.Parse(time.RFC3339, "2024-03-01T00:00:00Z")
+	until, _ := time.Parse(time.RFC3339, "2024-03-02T00:00:00Z")
+
+	cases := []struct {
+		name     string
+		entries  []*pagerduty.ScheduleLayerEntry
+		expected []map[string]interface{}
+	}{
+		{
+			name: "full coverage",
+			entries: []*pagerduty.ScheduleLayerEntry{
+				{Start: "2024-02-29T20:00:00Z", End: "2024-03-01T12:00:00Z"},
+				{Start: "2024-03-01T12:00:00Z", End: "2024-03-02T08:00:00Z"},
+			},
+			expected: []map[string]interface{}{},
+		},
+		{
+			name: "gap between entries and at the end",
+			entries: []*pagerduty.ScheduleLayerEntry{
+				{Start: "2024-03-01T14:00:00Z", End: "2024-03-01T20:00:00Z"},
+				{Start: "2024-03-01T00:00:00Z", End: "2024-03-01T09:00:00Z"},
+				{Start: "2024-03-01T08:00:00Z", End: "2024-03-01T10:00:00Z"},
+			},
+			expected: []map[string]interface{}{
+				{"start": "2024-03-01T10:00:00Z", "end": "2024-03-01T14:00:00Z"},
+				{"start": "2024-03-01T20:00:00Z", "end": "2024-03-02T00:00:00Z"},
+			},
+		},
+		{
+			name: "no entries",
+			expected: []map[string]interface{}{
+				{"start": "2024-03-01T00:00:00Z", "end": "2024-03-02T00:00:00Z"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		gaps, err := computeScheduleGaps(c.entries, since, until)
+		if err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+		if !reflect.DeepEqual(gaps, c.expected) {
+			t.Errorf("%s: expected gaps %v, got %v", c.name, c.expected, gaps)
+		}
+	}
+}
+
+func TestAccDataSourcePagerDutyScheduleGaps_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	start := time.Now().UTC().Add(24 * time.Hour).Truncate(24 * time.Hour)
+	since := start.Add(24 * time.Hour)
+	until := since.Add(24 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyScheduleGapsConfig(username, email, schedule, start.Format(time.RFC3339), since.Format(time.RFC3339), until.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_schedule_gaps.test", "gaps.#", "2"),
+					resource.TestCheckResourceAttr("data.pagerduty_schedule_gaps.test", "gaps.0.start", since.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr("data.pagerduty_schedule_gaps.test", "gaps.0.end", since.Add(9*time.Hour).Format(time.RFC3339)),
+					resource.TestCheckResourceAttr("data.pagerduty_schedule_gaps.test", "gaps.1.start", since.Add(17*time.Hour).Format(time.RFC3339)),
+					resource.TestCheckResourceAttr("data.pagerduty_schedule_gaps.test", "gaps.1.end", until.Format(time.RFC3339)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyScheduleGapsConfig(username, email, schedule, start, since, until string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_schedule" "test" {
+  name      = "%s"
+  time_zone = "UTC"
+
+  layer {
+    name                         = "office hours"
+    start                        = "%[4]s"
+    rotation_virtual_start       = "%[4]s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.test.id]
+
+    restriction {
+      type              = "daily_restriction"
+      start_time_of_day = "09:00:00"
+      duration_seconds  = 28800
+    }
+  }
+}
+
+data "pagerduty_schedule_gaps" "test" {
+  schedule_id = pagerduty_schedule.test.id
+  since       = "%[5]s"
+  until       = "%[6]s"
+}
+`, username, email, schedule, start, since, until)
+}
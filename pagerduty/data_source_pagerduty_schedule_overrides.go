@@ -0,0 +1,122 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func dataSourcePagerDutyScheduleOverrides() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePagerDutyScheduleOverridesRead,
+
+		Schema: map[string]*schema.Schema{
+			"schedule_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"since": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRFC3339,
+				Description:  "The start of the time window to list the overrides of, in RFC 3339 format",
+			},
+			"until": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRFC3339,
+				Description:  "The end of the time window to list the overrides of, in RFC 3339 format",
+			},
+			"overrides": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The overrides of the schedule overlapping the time window",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyScheduleOverridesRead(d *schema.ResourceData, meta interface{}) error {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	scheduleID := d.Get("schedule_id").(string)
+	since, _ := time.Parse(time.RFC3339, d.Get("since").(string))
+	until, _ := time.Parse(time.RFC3339, d.Get("until").(string))
+	if !until.After(since) {
+		return fmt.Errorf("until (%s) must be after since (%s)", d.Get("until"), d.Get("since"))
+	}
+
+	log.Printf("[INFO] Reading PagerDuty schedule %s overrides", scheduleID)
+
+	o := &pagerduty.ListOverridesOptions{
+		Since: since.Format(time.RFC3339),
+		Until: until.Format(time.RFC3339),
+	}
+
+	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+		resp, _, err := client.Schedules.ListOverrides(scheduleID, o)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(err)
+			}
+
+			time.Sleep(30 * time.Second)
+			return retry.RetryableError(err)
+		}
+
+		d.SetId(fmt.Sprintf("%s:%s:%s", scheduleID, o.Since, o.Until))
+		if err := d.Set("overrides", flattenScheduleOverrides(resp.Overrides)); err != nil {
+			return retry.NonRetryableError(fmt.Errorf("error setting overrides: %s", err))
+		}
+
+		return nil
+	})
+}
+
+func flattenScheduleOverrides(overrides []*pagerduty.Override) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(overrides))
+	for _, o := range overrides {
+		if o == nil {
+			continue
+		}
+		userID := ""
+		if o.User != nil {
+			userID = o.User.ID
+		}
+		result = append(result, map[string]interface{}{
+			"id":      o.ID,
+			"user_id": userID,
+			"start":   o.Start,
+			"end":     o.End,
+		})
+	}
+	return result
+}
@@ -0,0 +1,68 @@
+package pagerduty
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDataSourcePagerDutyScheduleOverrides(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/schedules/PSCHED1/overrides" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+			return
+		}
+		if since, until := r.URL.Query().Get("since"), r.URL.Query().Get("until"); since != "2024-03-04T00:00:00Z" || until != "2024-03-11T00:00:00Z" {
+			t.Errorf("expected the overrides of the window to be listed, got since %q and until %q", since, until)
+		}
+
+		w.Write([]byte(`{"overrides":[
+			{"id":"PQ47DCP","start":"2024-03-04T09:00:00Z","end":"2024-03-04T17:00:00Z","user":{"id":"PUSER01","type":"user_reference"}},
+			{"id":"PQ47DCQ","start":"2024-03-10T20:00:00Z","end":"2024-03-12T08:00:00Z","user":{"id":"PUSER02","type":"user_reference"}}
+		]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := dataSourcePagerDutyScheduleOverrides()
+	d := r.TestResourceData()
+	d.Set("schedule_id", "PSCHED1")
+	d.Set("since", "2024-03-04T00:00:00Z")
+	d.Set("until", "2024-03-11T00:00:00Z")
+	if err := dataSourcePagerDutyScheduleOverridesRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"id":                  "PSCHED1:2024-03-04T00:00:00Z:2024-03-11T00:00:00Z",
+		"overrides.#":         "2",
+		"overrides.0.id":      "PQ47DCP",
+		"overrides.0.user_id": "PUSER01",
+		"overrides.0.start":   "2024-03-04T09:00:00Z",
+		"overrides.0.end":     "2024-03-04T17:00:00Z",
+		"overrides.1.id":      "PQ47DCQ",
+		"overrides.1.user_id": "PUSER02",
+		"overrides.1.end":     "2024-03-12T08:00:00Z",
+	}
+	state := d.State()
+	for k, v := range expected {
+		if got := state.Attributes[k]; got != v {
+			t.Errorf("expected %s to be %q, got %q", k, v, got)
+		}
+	}
+
+	d = r.TestResourceData()
+	d.Set("schedule_id", "PSCHED1")
+	d.Set("since", "2024-03-11T00:00:00Z")
+	d.Set("until", "2024-03-04T00:00:00Z")
+	if err := dataSourcePagerDutyScheduleOverridesRead(d, config); err == nil {
+		t.Error("expected an error reading the overrides of a window ending before it starts")
+	}
+}
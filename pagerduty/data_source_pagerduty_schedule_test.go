@@ -2,6 +2,8 @@ package pagerduty
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -32,6 +34,118 @@ func TestAccDataSourcePagerDutySchedule_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutySchedule_Users(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	location := "Europe/Berlin"
+	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyScheduleUsersConfig(username, email, schedule, location, start),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_schedule.test", "users.#", "2"),
+					resource.TestCheckResourceAttrPair("pagerduty_schedule.test", "users.0", "pagerduty_user.foo", "id"),
+					resource.TestCheckResourceAttrPair("pagerduty_schedule.test", "users.1", "pagerduty_user.bar", "id"),
+					resource.TestCheckResourceAttr("data.pagerduty_schedule.by_name", "users.#", "2"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_schedule.by_name", "users.0", "pagerduty_user.foo", "id"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_schedule.by_name", "users.1", "pagerduty_user.bar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePagerDutySchedule_RenderedCoverage(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	location := "Europe/Berlin"
+	// A layer started before the render window without restrictions covers
+	// all of it.
+	start := timeNowInLoc(location).Add(-2 * time.Hour).Truncate(1 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyScheduleRenderedCoverageConfig(username, email, schedule, location, start),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_schedule.test", "layer.0.rendered_coverage_percentage", "100.00"),
+					resource.TestCheckResourceAttr("data.pagerduty_schedule.by_name", "layer.#", "1"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_schedule.by_name", "layer.0.id", "pagerduty_schedule.test", "layer.0.id"),
+					resource.TestCheckResourceAttr("data.pagerduty_schedule.by_name", "layer.0.name", "foo"),
+					resource.TestCheckResourceAttr("data.pagerduty_schedule.by_name", "layer.0.rendered_coverage_percentage", "100.00"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourcePagerDutySchedule_RenderedCoverage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/schedules":
+			w.Write([]byte(`{"schedules":[{"id":"PSCHED1","name":"primary"}]}`))
+		case "/schedules/PSCHED1":
+			since, err := time.Parse(time.RFC3339, r.URL.Query().Get("since"))
+			if err != nil {
+				t.Errorf("expected the schedule to be rendered since a time, got %q", r.URL.Query().Get("since"))
+			}
+			until, err := time.Parse(time.RFC3339, r.URL.Query().Get("until"))
+			if err != nil {
+				t.Errorf("expected the schedule to be rendered until a time, got %q", r.URL.Query().Get("until"))
+			}
+			if until.Sub(since) != scheduleRenderWindow {
+				t.Errorf("expected the schedule to be rendered over %s, got %s", scheduleRenderWindow, until.Sub(since))
+			}
+			// The layers are listed from the last one to the first one, and
+			// their coverage is a fraction of the render window.
+			w.Write([]byte(`{"schedule":{"id":"PSCHED1","name":"primary","schedule_layers":[
+				{"id":"PLAYER2","name":"partial","start":"2024-01-01T00:00:00Z","rotation_virtual_start":"2024-01-01T00:00:00Z","rotation_turn_length_seconds":86400,"users":[{"user":{"id":"PUSER02","type":"user_reference"}}],"rendered_coverage_percentage":0.33333},
+				{"id":"PLAYER1","name":"full","start":"2024-01-01T00:00:00Z","rotation_virtual_start":"2024-01-01T00:00:00Z","rotation_turn_length_seconds":86400,"users":[{"user":{"id":"PUSER01","type":"user_reference"}}],"rendered_coverage_percentage":1}
+			]}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := dataSourcePagerDutySchedule()
+	d := r.TestResourceData()
+	d.Set("name", "primary")
+	if err := r.Read(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"layer.#":                              "2",
+		"layer.0.id":                           "PLAYER1",
+		"layer.0.name":                         "full",
+		"layer.0.rendered_coverage_percentage": "100.00",
+		"layer.1.id":                           "PLAYER2",
+		"layer.1.rendered_coverage_percentage": "33.00",
+	}
+	state := d.State()
+	for k, v := range expected {
+		if got := state.Attributes[k]; got != v {
+			t.Errorf("expected %s to be %q, got %q", k, v, got)
+		}
+	}
+}
+
 func testAccDataSourcePagerDutySchedule(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -90,3 +204,70 @@ data "pagerduty_schedule" "by_name" {
 }
 `, username, email, schedule, location, start, rotationVirtualStart)
 }
+
+func testAccDataSourcePagerDutyScheduleUsersConfig(username, email, schedule, location, start string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]s-foo"
+  email = "foo.%[2]s"
+}
+
+resource "pagerduty_user" "bar" {
+  name  = "%[1]s-bar"
+  email = "bar.%[2]s"
+}
+
+resource "pagerduty_schedule" "test" {
+  name = "%[3]s"
+
+  time_zone = "%[4]s"
+
+  layer {
+    name                         = "foo"
+    start                        = "%[5]s"
+    rotation_virtual_start       = "%[5]s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.foo.id, pagerduty_user.bar.id]
+  }
+
+  layer {
+    name                         = "bar"
+    start                        = "%[5]s"
+    rotation_virtual_start       = "%[5]s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.bar.id, pagerduty_user.foo.id]
+  }
+}
+
+data "pagerduty_schedule" "by_name" {
+  name = pagerduty_schedule.test.name
+}
+`, username, email, schedule, location, start)
+}
+
+func testAccDataSourcePagerDutyScheduleRenderedCoverageConfig(username, email, schedule, location, start string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]s"
+  email = "%[2]s"
+}
+
+resource "pagerduty_schedule" "test" {
+  name = "%[3]s"
+
+  time_zone = "%[4]s"
+
+  layer {
+    name                         = "foo"
+    start                        = "%[5]s"
+    rotation_virtual_start       = "%[5]s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.foo.id]
+  }
+}
+
+data "pagerduty_schedule" "by_name" {
+  name = pagerduty_schedule.test.name
+}
+`, username, email, schedule, location, start)
+}
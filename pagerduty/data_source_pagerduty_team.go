@@ -38,7 +38,8 @@ func dataSourcePagerDutyTeam() *schema.Resource {
 }
 
 func dataSourcePagerDutyTeamRead(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	c := meta.(*Config)
+	client, err := c.Client()
 	if err != nil {
 		return err
 	}
@@ -46,6 +47,10 @@ func dataSourcePagerDutyTeamRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Reading PagerDuty team")
 
 	searchTeam := d.Get("name").(string)
+	if team, ok := c.cachedReference("pagerduty_team", searchTeam); ok {
+		setTeamDataSourceProps(d, team.(*pagerduty.Team))
+		return nil
+	}
 
 	o := &pagerduty.ListTeamsOptions{
 		Query: searchTeam,
@@ -79,12 +84,17 @@ func dataSourcePagerDutyTeamRead(d *schema.ResourceData, meta interface{}) error
 			)
 		}
 
-		d.SetId(found.ID)
-		d.Set("name", found.Name)
-		d.Set("description", found.Description)
-		d.Set("parent", found.Parent)
-		d.Set("default_role", found.DefaultRole)
+		c.cacheReference("pagerduty_team", searchTeam, found)
+		setTeamDataSourceProps(d, found)
 
 		return nil
 	})
 }
+
+func setTeamDataSourceProps(d *schema.ResourceData, team *pagerduty.Team) {
+	d.SetId(team.ID)
+	d.Set("name", team.Name)
+	d.Set("description", team.Description)
+	d.Set("parent", team.Parent)
+	d.Set("default_role", team.DefaultRole)
+}
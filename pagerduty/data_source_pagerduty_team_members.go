@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
@@ -53,7 +54,8 @@ func dataSourcePagerDutyTeamMembers() *schema.Resource {
 }
 
 func dataSourcePagerDutyTeamMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client, err := meta.(*Config).Client()
+	c := meta.(*Config)
+	client, err := c.Client()
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -65,7 +67,7 @@ func dataSourcePagerDutyTeamMembersRead(ctx context.Context, d *schema.ResourceD
 	retryErr := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
 		resp, _, err := client.Teams.GetMembers(teamID, &pagerduty.GetMembersOptions{})
 		if err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusForbidden) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -93,7 +95,7 @@ func dataSourcePagerDutyTeamMembersRead(ctx context.Context, d *schema.ResourceD
 	})
 
 	if retryErr != nil {
-		return diag.FromErr(retryErr)
+		return diag.FromErr(c.explainTeamScopeError(ctx, retryErr, fmt.Sprintf("Team %s", teamID)))
 	}
 
 	return nil
@@ -0,0 +1,143 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/persistentconfig"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
+)
+
+// appOauthTokenURL is the endpoint issuing the App OAuth scoped tokens, its
+// responses list the scopes granted to the App.
+const appOauthTokenURL = "https://identity.pagerduty.com/oauth/token"
+
+func dataSourcePagerDutyTokenScopes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePagerDutyTokenScopesRead,
+
+		Schema: map[string]*schema.Schema{
+			"token_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the token used by the provider, either api_token or scoped_oauth_token",
+			},
+			"scopes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The scopes granted to the token, empty for API tokens which don't have scopes",
+			},
+		},
+	}
+}
+
+func dataSourcePagerDutyTokenScopesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	tokenType := "api_token"
+	scopes := []string{}
+	if config.AppOauthScopedTokenParams != nil {
+		log.Printf("[INFO] Reading the scopes of the PagerDuty App OAuth scoped token")
+
+		tokenType = "scoped_oauth_token"
+		var err error
+		scopes, err = config.appOauthTokenScopes(ctx, appOauthTokenURL)
+		if err != nil {
+			return diag.Errorf("error reading the scopes of the App OAuth scoped token: %s", err)
+		}
+	}
+
+	d.SetId(tokenType)
+	d.Set("token_type", tokenType)
+	if err := d.Set("scopes", scopes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// appOauthTokenScopes returns the scopes granted to the App OAuth scoped token
+// of the provider. The API client keeps only the token it obtained, and the
+// scopes are only returned when a token is issued, so a token is requested
+// once per provider run and its scopes are kept for the reads that follow.
+// The request goes through the transport of the provider, without the logging
+// of the API requests as its body holds the client secret.
+func (c *Config) appOauthTokenScopes(ctx context.Context, tokenURL string) ([]string, error) {
+	c = c.root()
+	c.tokenScopesMu.Lock()
+	defer c.tokenScopesMu.Unlock()
+
+	if c.tokenScopes != nil {
+		return c.tokenScopes, nil
+	}
+
+	httpClient := &http.Client{Transport: c.httpTransport(), Timeout: 2 * time.Minute}
+	scopes, err := fetchAppOauthTokenScopes(ctx, httpClient, tokenURL, c.AppOauthScopedTokenParams, c.userAgent())
+	if err != nil {
+		return nil, err
+	}
+	c.tokenScopes = scopes
+
+	return scopes, nil
+}
+
+// fetchAppOauthTokenScopes requests a token for the App credentials the same
+// way the API client does and returns the scopes granted in the response,
+// leaving out the scope of the account the token is issued for.
+func fetchAppOauthTokenScopes(ctx context.Context, httpClient *http.Client, tokenURL string, params *persistentconfig.AppOauthScopedTokenParams, userAgent string) ([]string, error) {
+	region := params.Region
+	if region == "" {
+		region = "us"
+	}
+
+	data := url.Values{}
+	data.Add("grant_type", "client_credentials")
+	data.Add("client_id", params.ClientID)
+	data.Add("client_secret", params.ClientSecret)
+	data.Add("scope", fmt.Sprintf("as_account-%s.%s %s", region, params.PDSubDomain, strings.Join(util.AvailableOauthScopes(), " ")))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Add("User-Agent", userAgent)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, fmt.Errorf("token request failed with status code %d", resp.StatusCode)
+	}
+
+	var v struct {
+		Scope string `json:"scope"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	scopes := []string{}
+	for _, s := range strings.Fields(v.Scope) {
+		if strings.HasPrefix(s, "as_account-") {
+			continue
+		}
+		scopes = append(scopes, s)
+	}
+	sort.Strings(scopes)
+
+	return scopes, nil
+}
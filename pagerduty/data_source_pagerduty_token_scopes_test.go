@@ -0,0 +1,115 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/heimweh/go-pagerduty/persistentconfig"
+)
+
+const testAppOauthTokenResponse = `{
+  "access_token": "pdus+_0XBPWQQ_secret",
+  "scope": "as_account-eu.acme services.read incidents.write abilities.read",
+  "token_type": "bearer",
+  "expires_in": 864000
+}`
+
+func TestFetchAppOauthTokenScopes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if got := r.PostForm.Get("client_id"); got != "client" {
+			t.Errorf("expected the client_id to be sent, got %q", got)
+		}
+		if got := r.PostForm.Get("scope"); !strings.HasPrefix(got, "as_account-eu.acme ") {
+			t.Errorf("expected the scope to start with the account, got %q", got)
+		}
+		w.Write([]byte(testAppOauthTokenResponse))
+	}))
+	defer server.Close()
+
+	params := &persistentconfig.AppOauthScopedTokenParams{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		PDSubDomain:  "acme",
+		Region:       "eu",
+	}
+	scopes, err := fetchAppOauthTokenScopes(context.Background(), server.Client(), server.URL, params, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"abilities.read", "incidents.write", "services.read"}
+	if !reflect.DeepEqual(scopes, expected) {
+		t.Errorf("expected scopes %v, got %v", expected, scopes)
+	}
+}
+
+func TestFetchAppOauthTokenScopesError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	_, err := fetchAppOauthTokenScopes(context.Background(), server.Client(), server.URL, &persistentconfig.AppOauthScopedTokenParams{}, "test")
+	if err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("expected an error with the status code, got %v", err)
+	}
+}
+
+func TestConfigAppOauthTokenScopes(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if got := r.Header.Get("User-Agent"); got != "test suffix" {
+			t.Errorf("expected the user agent suffix to be sent, got %q", got)
+		}
+		w.Write([]byte(testAppOauthTokenResponse))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		UserAgent:       "test",
+		UserAgentSuffix: "suffix",
+		AppOauthScopedTokenParams: &persistentconfig.AppOauthScopedTokenParams{
+			ClientID:     "client",
+			ClientSecret: "secret",
+			PDSubDomain:  "acme",
+			Region:       "eu",
+		},
+	}
+	for i := 0; i < 2; i++ {
+		scopes, err := config.appOauthTokenScopes(context.Background(), server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := []string{"abilities.read", "incidents.write", "services.read"}; !reflect.DeepEqual(scopes, expected) {
+			t.Errorf("expected scopes %v, got %v", expected, scopes)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected a single token to be requested for the run, got %d requests", requests)
+	}
+}
+
+func TestAccDataSourcePagerDutyTokenScopes_APIToken(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "pagerduty_token_scopes" "current" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_token_scopes.current", "token_type", "api_token"),
+					resource.TestCheckResourceAttr("data.pagerduty_token_scopes.current", "scopes.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -45,7 +45,8 @@ func dataSourcePagerDutyUser() *schema.Resource {
 }
 
 func dataSourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	c := meta.(*Config)
+	client, err := c.Client()
 	if err != nil {
 		return err
 	}
@@ -53,6 +54,10 @@ func dataSourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Reading PagerDuty user")
 
 	searchEmail := d.Get("email").(string)
+	if user, ok := c.cachedReference("pagerduty_user", searchEmail); ok {
+		setUserDataSourceProps(d, user.(*pagerduty.FullUser))
+		return nil
+	}
 
 	o := &pagerduty.ListUsersOptions{
 		Query: searchEmail,
@@ -86,14 +91,19 @@ func dataSourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error
 			)
 		}
 
-		d.SetId(found.ID)
-		d.Set("name", found.Name)
-		d.Set("email", found.Email)
-		d.Set("role", found.Role)
-		d.Set("job_title", found.JobTitle)
-		d.Set("time_zone", found.TimeZone)
-		d.Set("description", found.Description)
+		c.cacheReference("pagerduty_user", searchEmail, found)
+		setUserDataSourceProps(d, found)
 
 		return nil
 	})
 }
+
+func setUserDataSourceProps(d *schema.ResourceData, user *pagerduty.FullUser) {
+	d.SetId(user.ID)
+	d.Set("name", user.Name)
+	d.Set("email", user.Email)
+	d.Set("role", user.Role)
+	d.Set("job_title", user.JobTitle)
+	d.Set("time_zone", user.TimeZone)
+	d.Set("description", user.Description)
+}
@@ -1,9 +1,12 @@
 package pagerduty
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -23,6 +26,22 @@ func dataSourcePagerDutyUsers() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"emails": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of emails of the users to look for",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"missing_emails": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of the emails which don't belong to any user",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"users": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -68,8 +87,13 @@ func dataSourcePagerDutyUsers() *schema.Resource {
 	}
 }
 
+// usersByEmailQueryLimit is the number of emails above which listing all the
+// users once takes fewer requests than querying the users of each email.
+const usersByEmailQueryLimit = 20
+
 func dataSourcePagerDutyUsersRead(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	c := meta.(*Config)
+	client, err := c.Client()
 	if err != nil {
 		return err
 	}
@@ -82,14 +106,60 @@ func dataSourcePagerDutyUsersRead(d *schema.ResourceData, meta interface{}) erro
 		teamIds = append(teamIds, ti.(string))
 	}
 
+	// The tokens scoped to teams only list the users of their teams.
+	teamIds, err = c.scopedTeamIDs(context.Background(), teamIds)
+	if err != nil {
+		return err
+	}
+
 	o := &pagerduty.ListUsersOptions{
 		TeamIDs: teamIds,
 	}
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	var emails []string
+	for _, e := range d.Get("emails").([]interface{}) {
+		emails = append(emails, e.(string))
+	}
+
+	var found []*pagerduty.FullUser
+	missing := []string{}
+	if len(emails) == 0 {
+		found, err = listAllUsers(client, o)
+	} else {
+		found, missing, err = listUsersByEmail(client, o, emails)
+	}
+	if err != nil {
+		return c.explainTeamScopeError(context.Background(), err, fmt.Sprintf("Listing the users of the teams %s", strings.Join(teamIds, ", ")))
+	}
+
+	var users []map[string]interface{}
+	for _, user := range found {
+		users = append(users, map[string]interface{}{
+			"id":          user.ID,
+			"name":        user.Name,
+			"email":       user.Email,
+			"role":        user.Role,
+			"job_title":   user.JobTitle,
+			"time_zone":   user.TimeZone,
+			"description": user.Description,
+		})
+	}
+
+	// Since this data doesn't have an unique ID, this force this data to be
+	// refreshed in every Terraform apply
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("users", users)
+	d.Set("missing_emails", missing)
+
+	return nil
+}
+
+func listAllUsers(client *pagerduty.Client, o *pagerduty.ListUsersOptions) ([]*pagerduty.FullUser, error) {
+	var users []*pagerduty.FullUser
+	err := retry.Retry(5*time.Minute, func() *retry.RetryError {
 		resp, err := client.Users.ListAll(o)
 		if err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusForbidden) {
 				return retry.NonRetryableError(err)
 			}
 
@@ -99,24 +169,62 @@ func dataSourcePagerDutyUsersRead(d *schema.ResourceData, meta interface{}) erro
 			return retry.RetryableError(err)
 		}
 
-		var users []map[string]interface{}
-		for _, user := range resp {
-			users = append(users, map[string]interface{}{
-				"id":          user.ID,
-				"name":        user.Name,
-				"email":       user.Email,
-				"role":        user.Role,
-				"job_title":   user.JobTitle,
-				"time_zone":   user.TimeZone,
-				"description": user.Description,
-			})
+		users = resp
+		return nil
+	})
+
+	return users, err
+}
+
+// listUsersByEmail returns the users with the given emails, in the order of
+// the emails, and the emails without a matching user. Emails are compared
+// case insensitively, as PagerDuty does.
+func listUsersByEmail(client *pagerduty.Client, o *pagerduty.ListUsersOptions, emails []string) ([]*pagerduty.FullUser, []string, error) {
+	var unique []string
+	seen := make(map[string]bool)
+	for _, e := range emails {
+		key := strings.ToLower(e)
+		if seen[key] {
+			continue
 		}
+		seen[key] = true
+		unique = append(unique, e)
+	}
 
-		// Since this data doesn't have an unique ID, this force this data to be
-		// refreshed in every Terraform apply
-		d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-		d.Set("users", users)
+	byEmail := make(map[string]*pagerduty.FullUser)
+	index := func(list []*pagerduty.FullUser) {
+		for _, u := range list {
+			byEmail[strings.ToLower(u.Email)] = u
+		}
+	}
 
-		return nil
-	})
+	if len(unique) > usersByEmailQueryLimit {
+		list, err := listAllUsers(client, o)
+		if err != nil {
+			return nil, nil, err
+		}
+		index(list)
+	} else {
+		for _, e := range unique {
+			qo := *o
+			qo.Query = e
+			list, err := listAllUsers(client, &qo)
+			if err != nil {
+				return nil, nil, err
+			}
+			index(list)
+		}
+	}
+
+	var users []*pagerduty.FullUser
+	missing := []string{}
+	for _, e := range unique {
+		if u, ok := byEmail[strings.ToLower(e)]; ok {
+			users = append(users, u)
+		} else {
+			missing = append(missing, e)
+		}
+	}
+
+	return users, missing, nil
 }
@@ -112,6 +112,39 @@ func TestAccDataSourcePagerDutyUsers_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyUsers_ByEmails(t *testing.T) {
+	username1 := fmt.Sprintf("tf-user1-%s", acctest.RandString(5))
+	email1 := fmt.Sprintf("%s@foo.test", username1)
+	username2 := fmt.Sprintf("tf-user2-%s", acctest.RandString(5))
+	email2 := fmt.Sprintf("%s@foo.test", username2)
+	missingEmail := fmt.Sprintf("tf-missing-%s@foo.test", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyUsersByEmailsConfig(username1, email1, username2, email2, missingEmail),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourcePagerDutyUsersExists("data.pagerduty_users.by_emails"),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_users.by_emails", "users.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_users.by_emails", "users.0.name", username2),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_users.by_emails", "users.0.email", email2),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_users.by_emails", "users.1.name", username1),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_users.by_emails", "missing_emails.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_users.by_emails", "missing_emails.0", missingEmail),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourcePagerDutyUsersExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -181,3 +214,22 @@ func testAccDataSourcePagerDutyUsersConfig(teamname1, teamname2, username1, emai
     }
 `, teamname1, teamname2, username1, email1, title1, timeZone1, description1, username2, email2, title2, timeZone2, description2, username3, email3, title3, timeZone3, description3)
 }
+
+func testAccDataSourcePagerDutyUsersByEmailsConfig(username1, email1, username2, email2, missingEmail string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test1" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_user" "test2" {
+  name  = "%s"
+  email = "%s"
+}
+
+data "pagerduty_users" "by_emails" {
+  depends_on = [pagerduty_user.test1, pagerduty_user.test2]
+  emails     = [upper(pagerduty_user.test2.email), "%s", pagerduty_user.test1.email]
+}
+`, username1, email1, username2, email2, missingEmail)
+}
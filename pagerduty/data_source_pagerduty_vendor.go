@@ -4,9 +4,9 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"regexp"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,6 +43,9 @@ func dataSourcePagerDutyVendorRead(d *schema.ResourceData, meta interface{}) err
 	o := &pagerduty.ListVendorsOptions{
 		Query: searchName,
 	}
+	if name, ok := wellKnownVendorNames[normalizeVendorName(searchName)]; ok {
+		o.Query = name
+	}
 	return retry.Retry(5*time.Minute, func() *retry.RetryError {
 		resp, _, err := client.Vendors.List(o)
 		if err != nil {
@@ -56,30 +59,9 @@ func dataSourcePagerDutyVendorRead(d *schema.ResourceData, meta interface{}) err
 			return retry.RetryableError(err)
 		}
 
-		var found *pagerduty.Vendor
-
-		for _, vendor := range resp.Vendors {
-			if strings.EqualFold(vendor.Name, searchName) {
-				found = vendor
-				break
-			}
-		}
-
-		// We didn't find an exact match, so let's fallback to partial matching.
-		if found == nil {
-			pr := regexp.MustCompile("(?i)" + searchName)
-			for _, vendor := range resp.Vendors {
-				if pr.MatchString(vendor.Name) {
-					found = vendor
-					break
-				}
-			}
-		}
-
-		if found == nil {
-			return retry.NonRetryableError(
-				fmt.Errorf("Unable to locate any vendor with the name: %s", searchName),
-			)
+		found, err := findVendor(resp.Vendors, searchName)
+		if err != nil {
+			return retry.NonRetryableError(err)
 		}
 
 		d.SetId(found.ID)
@@ -89,3 +71,80 @@ func dataSourcePagerDutyVendorRead(d *schema.ResourceData, meta interface{}) err
 		return nil
 	})
 }
+
+// wellKnownVendorNames maps the normalized names commonly used to refer to
+// some popular vendors to their name in the PagerDuty API, so they're found
+// even when they're not referred to by their exact name.
+var wellKnownVendorNames = map[string]string{
+	"amazoncloudwatch": "Amazon CloudWatch",
+	"awscloudwatch":    "Amazon CloudWatch",
+	"cloudwatch":       "Amazon CloudWatch",
+	"datadog":          "Datadog",
+	"dynatrace":        "Dynatrace",
+	"email":            "Email",
+	"grafana":          "Grafana",
+	"nagios":           "Nagios",
+	"newrelic":         "New Relic",
+	"pingdom":          "Pingdom",
+	"prometheus":       "Prometheus",
+	"sentry":           "Sentry",
+	"splunk":           "Splunk",
+	"zabbix":           "Zabbix",
+}
+
+// normalizeVendorName lowercases name and strips anything but letters and
+// digits from it, so "New Relic" and "newrelic" are considered the same name.
+func normalizeVendorName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// findVendor looks for the vendor named searchName, first by exact name, then
+// by normalized name, resolving the names of well known vendors, and finally
+// by partial match. The candidates are reported when more than one vendor
+// matches.
+func findVendor(vendors []*pagerduty.Vendor, searchName string) (*pagerduty.Vendor, error) {
+	for _, vendor := range vendors {
+		if strings.EqualFold(vendor.Name, searchName) {
+			return vendor, nil
+		}
+	}
+
+	normalized := normalizeVendorName(searchName)
+	if name, ok := wellKnownVendorNames[normalized]; ok {
+		normalized = normalizeVendorName(name)
+	}
+
+	var candidates []*pagerduty.Vendor
+	for _, vendor := range vendors {
+		if normalizeVendorName(vendor.Name) == normalized {
+			candidates = append(candidates, vendor)
+		}
+	}
+	if len(candidates) == 0 {
+		// We didn't find an exact match, so let's fallback to partial matching.
+		partial := strings.ToLower(searchName)
+		for _, vendor := range vendors {
+			if strings.Contains(strings.ToLower(vendor.Name), partial) {
+				candidates = append(candidates, vendor)
+			}
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0], nil
+	}
+	if len(candidates) > 1 {
+		names := make([]string, 0, len(candidates))
+		for _, vendor := range candidates {
+			names = append(names, fmt.Sprintf("%q (%s)", vendor.Name, vendor.ID))
+		}
+		return nil, fmt.Errorf("Multiple vendors match the name %s: %s. Use the exact name of one of them", searchName, strings.Join(names, ", "))
+	}
+
+	return nil, fmt.Errorf("Unable to locate any vendor with the name: %s", searchName)
+}
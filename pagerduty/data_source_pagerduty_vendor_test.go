@@ -1,9 +1,11 @@
 package pagerduty
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func TestAccDataSourcePagerDutyVendor_Basic(t *testing.T) {
@@ -60,6 +62,59 @@ func TestAccDataSourcePagerDutyVendor_SpecialChars(t *testing.T) {
 	})
 }
 
+func TestFindVendor(t *testing.T) {
+	vendors := []*pagerduty.Vendor{
+		{ID: "PZQ6AUS", Name: "Amazon CloudWatch"},
+		{ID: "PWQ6HJP", Name: "Amazon CloudWatch Events"},
+		{ID: "PAM4FGS", Name: "Datadog"},
+		{ID: "PCQ6ZBA", Name: "New Relic"},
+		{ID: "PKAPG94", Name: "Sentry"},
+		{ID: "PSPLNK1", Name: "Splunk"},
+		{ID: "PSPLNK2", Name: "splunk"},
+	}
+
+	cases := []struct {
+		name     string
+		expected string
+	}{
+		{"Datadog", "PAM4FGS"},
+		{"datadog", "PAM4FGS"},
+		{"DataDog", "PAM4FGS"},
+		{"cloudwatch", "PZQ6AUS"},
+		{"AWS CloudWatch", "PZQ6AUS"},
+		{"Amazon CloudWatch Events", "PWQ6HJP"},
+		{"newrelic", "PCQ6ZBA"},
+		{"new-relic", "PCQ6ZBA"},
+		{"sentry", "PKAPG94"},
+		{"relic", "PCQ6ZBA"},
+	}
+
+	for _, c := range cases {
+		vendor, err := findVendor(vendors, c.name)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", c.name, err)
+			continue
+		}
+		if vendor.ID != c.expected {
+			t.Errorf("%q: expected vendor %s, got %s (%s)", c.name, c.expected, vendor.ID, vendor.Name)
+		}
+	}
+
+	if _, err := findVendor(vendors, "Splunk "); err == nil || !regexp.MustCompile(`"Splunk" \(PSPLNK1\), "splunk" \(PSPLNK2\)`).MatchString(err.Error()) {
+		t.Errorf("expected an error listing the candidates of an ambiguous name, got %v", err)
+	}
+
+	if _, err := findVendor(vendors, "amazon"); err == nil || !regexp.MustCompile(`"Amazon CloudWatch" \(PZQ6AUS\), "Amazon CloudWatch Events" \(PWQ6HJP\)`).MatchString(err.Error()) {
+		t.Errorf("expected an error listing the candidates of an ambiguous partial name, got %v", err)
+	}
+
+	for _, name := range []string{"C++", "(beta)", "Nagios"} {
+		if _, err := findVendor(vendors, name); err == nil {
+			t.Errorf("%q: expected an error for a vendor not found", name)
+		}
+	}
+}
+
 const testAccDataSourcePagerDutyVendorConfig = `
 data "pagerduty_vendor" "foo" {
   name = "cloudwatch"
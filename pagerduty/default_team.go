@@ -0,0 +1,57 @@
+package pagerduty
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// applyDefaultTeam returns the default team of the provider as the teams of a
+// resource which doesn't configure any.
+func applyDefaultTeam(teams []*pagerduty.TeamReference, meta interface{}) []*pagerduty.TeamReference {
+	c, ok := meta.(*Config)
+	if len(teams) > 0 || !ok || c.DefaultTeam == "" {
+		return teams
+	}
+
+	return []*pagerduty.TeamReference{{ID: c.DefaultTeam, Type: "team_reference"}}
+}
+
+// omitDefaultTeam leaves the default team of the provider out of the teams
+// read from the API when it was assigned by applyDefaultTeam, so resources
+// which don't configure teams don't show a diff for it.
+func omitDefaultTeam(d *schema.ResourceData, teams []string, meta interface{}) []string {
+	c, ok := meta.(*Config)
+	if !ok || c.DefaultTeam == "" || len(teams) != 1 || teams[0] != c.DefaultTeam {
+		return teams
+	}
+	if len(d.Get("teams").([]interface{})) > 0 {
+		return teams
+	}
+
+	return []string{}
+}
+
+// applyDefaultTeamID returns the default team of the provider as the team of a
+// resource taking a single team which doesn't configure any.
+func applyDefaultTeamID(team string, meta interface{}) string {
+	c, ok := meta.(*Config)
+	if team != "" || !ok {
+		return team
+	}
+
+	return c.DefaultTeam
+}
+
+// isUnconfiguredDefaultTeam reports whether the team read from the API is the
+// default team of the provider assigned by applyDefaultTeamID, because the
+// resource doesn't configure the team set under key. Those resources leave it
+// out of their state so they don't show a diff for it.
+func isUnconfiguredDefaultTeam(d *schema.ResourceData, key, team string, meta interface{}) bool {
+	c, ok := meta.(*Config)
+	if !ok || c.DefaultTeam == "" || team != c.DefaultTeam {
+		return false
+	}
+	_, configured := d.GetOk(key)
+
+	return !configured
+}
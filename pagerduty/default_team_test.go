@@ -0,0 +1,35 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestEventOrchestrationDefaultTeam(t *testing.T) {
+	meta := &Config{DefaultTeam: "PTEAM01"}
+	r := resourcePagerDutyEventOrchestration()
+
+	d := r.TestResourceData()
+	d.Set("name", "foo")
+	if o := buildEventOrchestrationStruct(d, meta); o.Team == nil || o.Team.ID == nil || *o.Team.ID != "PTEAM01" {
+		t.Errorf("expected the orchestration without team to get the default team, got %+v", o.Team)
+	}
+
+	teamID := "PTEAM01"
+	setEventOrchestrationProps(d, &pagerduty.EventOrchestration{Name: "foo", Team: &pagerduty.EventOrchestrationObject{ID: &teamID}}, meta)
+	if team := d.Get("team").(string); team != "" {
+		t.Errorf("expected the default team to be left out of the state, got %q", team)
+	}
+
+	d.Set("team", "PTEAM02")
+	if o := buildEventOrchestrationStruct(d, meta); o.Team == nil || o.Team.ID == nil || *o.Team.ID != "PTEAM02" {
+		t.Errorf("expected the configured team to override the default team, got %+v", o.Team)
+	}
+
+	d.Set("team", "PTEAM01")
+	setEventOrchestrationProps(d, &pagerduty.EventOrchestration{Name: "foo", Team: &pagerduty.EventOrchestrationObject{ID: &teamID}}, meta)
+	if team := d.Get("team").(string); team != "PTEAM01" {
+		t.Errorf("expected the configured default team to be kept in the state, got %q", team)
+	}
+}
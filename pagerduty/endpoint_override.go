@@ -0,0 +1,173 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func expandEndpointOverrides(v interface{}) map[string]string {
+	overrides := map[string]string{}
+	m, _ := v.(map[string]interface{})
+	for resourceType, raw := range m {
+		if u, ok := raw.(string); ok {
+			overrides[resourceType] = u
+		}
+	}
+	return overrides
+}
+
+// validateEndpointOverrideURL checks the alternate base URL of a resource type
+// is an absolute HTTP(S) URL, the API client would otherwise fail on the first
+// request with an error not naming the resource type.
+func validateEndpointOverrideURL(v string) error {
+	u, err := url.Parse(v)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid URL: %s", v, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%q must be an absolute URL with the http or https scheme, like https://api.example.com", v)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("%q must not have a query or a fragment, it's the base URL the paths of the API are appended to", v)
+	}
+	return nil
+}
+
+// validateEndpointOverrideTypes warns about the endpoint_overrides of resource
+// types that aren't routed, like the ones of the plugin framework part of the
+// provider or misspelled ones.
+func validateEndpointOverrideTypes(data *schema.ResourceData, resources map[string]*schema.Resource) diag.Diagnostics {
+	var unknown []string
+	for resourceType := range expandEndpointOverrides(data.Get("endpoint_overrides")) {
+		if _, ok := resources[resourceType]; !ok {
+			unknown = append(unknown, resourceType)
+		}
+	}
+	sort.Strings(unknown)
+
+	var diags diag.Diagnostics
+	for _, resourceType := range unknown {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("`endpoint_overrides` of %s is ignored", resourceType),
+			Detail:        fmt.Sprintf("%s isn't a resource type whose requests can be sent to another base URL, the requests of its resources keep being sent to the PagerDuty API.", resourceType),
+			AttributePath: cty.GetAttrPath("endpoint_overrides").IndexString(resourceType),
+		})
+	}
+	return diags
+}
+
+// endpointConfig returns the Config the resources of resourceType use, which
+// sends their requests to the base URL it has in EndpointOverrides. Resource
+// types sharing a base URL share a Config and its client. The client stats,
+// the deprecation notices and the caches of the provider run stay with the
+// Config of the provider, see root.
+func (c *Config) endpointConfig(resourceType string) *Config {
+	override, ok := c.EndpointOverrides[resourceType]
+	if !ok {
+		return c
+	}
+	override = strings.TrimSuffix(override, "/")
+
+	c.endpointConfigsMu.Lock()
+	defer c.endpointConfigsMu.Unlock()
+
+	if cached, ok := c.endpointConfigs[override]; ok {
+		return cached
+	}
+
+	config := &Config{
+		ApiUrl:                    c.ApiUrl,
+		ApiUrlOverride:            override,
+		AppUrl:                    c.AppUrl,
+		Token:                     c.Token,
+		UserToken:                 c.UserToken,
+		SkipCredsValidation:       c.SkipCredsValidation,
+		UserAgent:                 c.UserAgent,
+		UserAgentSuffix:           c.UserAgentSuffix,
+		InsecureTls:               c.InsecureTls,
+		ValidateConditions:        c.ValidateConditions,
+		StrictMissing:             c.StrictMissing,
+		DialTimeout:               c.DialTimeout,
+		KeepAlive:                 c.KeepAlive,
+		DefaultTeam:               c.DefaultTeam,
+		APITokenType:              c.APITokenType,
+		AppOauthScopedTokenParams: c.AppOauthScopedTokenParams,
+		ServiceRegion:             c.ServiceRegion,
+		parent:                    c,
+	}
+	if c.endpointConfigs == nil {
+		c.endpointConfigs = map[string]*Config{}
+	}
+	c.endpointConfigs[override] = config
+
+	return config
+}
+
+// root returns the Config the provider was configured with, which holds the
+// state of the provider run: the client stats, the deprecation notices and the
+// caches of the objects of the account, which are requested through its client.
+func (c *Config) root() *Config {
+	if c.parent != nil {
+		return c.parent
+	}
+	return c
+}
+
+// routeEndpointOverride wraps the operations of the resource r, so they run
+// with the Config of endpointConfig. It must be called after the wrappers
+// turning the operations into their context aware variants, like
+// reportAPIDeprecations.
+func routeEndpointOverride(name string, r *schema.Resource) {
+	route := func(meta interface{}) interface{} {
+		if c, ok := meta.(*Config); ok {
+			return c.endpointConfig(name)
+		}
+		return meta
+	}
+	wrap := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, route(meta))
+		}
+	}
+
+	r.CreateContext = wrap(r.CreateContext)
+	r.CreateWithoutTimeout = wrap(r.CreateWithoutTimeout)
+	r.ReadContext = wrap(r.ReadContext)
+	r.ReadWithoutTimeout = wrap(r.ReadWithoutTimeout)
+	r.UpdateContext = wrap(r.UpdateContext)
+	r.UpdateWithoutTimeout = wrap(r.UpdateWithoutTimeout)
+	r.DeleteContext = wrap(r.DeleteContext)
+	r.DeleteWithoutTimeout = wrap(r.DeleteWithoutTimeout)
+
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return customizeDiff(ctx, diff, route(meta))
+		}
+	}
+
+	if r.Importer != nil {
+		switch {
+		case r.Importer.StateContext != nil:
+			state := r.Importer.StateContext
+			r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return state(ctx, d, route(meta))
+			}
+		case r.Importer.State != nil:
+			state := r.Importer.State
+			r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return state(d, route(meta))
+			}
+		}
+	}
+}
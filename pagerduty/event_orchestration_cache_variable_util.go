@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -34,8 +35,9 @@ var resourceEventOrchestrationCacheVariableConfigurationSchema = map[string]*sch
 		Optional: true,
 	},
 	"source": {
-		Type:     schema.TypeString,
-		Optional: true,
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validateEventOrchestrationPathFieldPath(false),
 	},
 	"ttl_seconds": {
 		Type:     schema.TypeInt,
@@ -70,6 +72,13 @@ var dataSourceEventOrchestrationCacheVariableConfigurationSchema = map[string]*s
 }
 
 func checkConfiguration(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	// The type-specific fields can't be checked until all of them are known.
+	for _, k := range []string{"type", "source", "regex", "ttl_seconds"} {
+		if !diff.NewValueKnown("configuration.0." + k) {
+			return nil
+		}
+	}
+
 	t := diff.Get("configuration.0.type").(string)
 	s := diff.Get("configuration.0.source").(string)
 	r := diff.Get("configuration.0.regex").(string)
@@ -84,6 +93,11 @@ func checkConfiguration(context context.Context, diff *schema.ResourceDiff, i in
 	if (r != "" || s != "") && ts != 0 {
 		return fmt.Errorf("Invalid configuration: ttl_seconds cannot be used in conjuction with regex and source")
 	}
+	if r != "" {
+		if _, err := regexp.Compile(r); err != nil {
+			return fmt.Errorf("Invalid configuration: regex %q is not a valid regular expression: %s", r, err)
+		}
+	}
 	return nil
 }
 
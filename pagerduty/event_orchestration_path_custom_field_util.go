@@ -0,0 +1,250 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// forEachEventOrchestrationPathActions calls f with the key and the actions of
+// each rule and of the catch all of the flattened sets and catch_all of an
+// Event Orchestration Path.
+func forEachEventOrchestrationPathActions(sets, catchAll []interface{}, f func(key string, actions map[string]interface{})) {
+	visit := func(key string, v interface{}) {
+		actions, _ := v.([]interface{})
+		if len(actions) == 0 {
+			return
+		}
+		if a, ok := actions[0].(map[string]interface{}); ok {
+			f(key, a)
+		}
+	}
+
+	for si, s := range sets {
+		set, _ := s.(map[string]interface{})
+		rules, _ := set["rule"].([]interface{})
+		for ri, r := range rules {
+			rule, _ := r.(map[string]interface{})
+			visit(fmt.Sprintf("set.%d.rule.%d.actions.0", si, ri), rule["actions"])
+		}
+	}
+	if len(catchAll) > 0 {
+		if ca, ok := catchAll[0].(map[string]interface{}); ok {
+			visit("catch_all.0.actions.0", ca["actions"])
+		}
+	}
+}
+
+// eventOrchestrationPathIncidentCustomFieldNames returns the names set on the
+// incident_custom_field_update actions of an Event Orchestration Path, by the
+// key of the action.
+func eventOrchestrationPathIncidentCustomFieldNames(d *schema.ResourceData) map[string]string {
+	names := map[string]string{}
+
+	sets, _ := d.Get("set").([]interface{})
+	catchAll, _ := d.Get("catch_all").([]interface{})
+	forEachEventOrchestrationPathActions(sets, catchAll, func(key string, actions map[string]interface{}) {
+		updates, _ := actions["incident_custom_field_update"].([]interface{})
+		for ui, u := range updates {
+			update, _ := u.(map[string]interface{})
+			if name, _ := update["name"].(string); name != "" {
+				names[fmt.Sprintf("%s.incident_custom_field_update.%d", key, ui)] = name
+			}
+		}
+	})
+
+	return names
+}
+
+// resolveEventOrchestrationPathIncidentCustomFields replaces the IDs of the
+// incident custom fields set by name on the actions of an Event Orchestration
+// Path with the IDs of the fields they reference.
+func resolveEventOrchestrationPathIncidentCustomFields(ctx context.Context, c *Config, d *schema.ResourceData, p *pagerduty.EventOrchestrationPath) error {
+	names := eventOrchestrationPathIncidentCustomFieldNames(d)
+	if len(names) == 0 {
+		return nil
+	}
+
+	resolve := func(key string, actions *pagerduty.EventOrchestrationPathRuleActions) error {
+		if actions == nil {
+			return nil
+		}
+		for ui, u := range actions.IncidentCustomFieldUpdates {
+			name, ok := names[fmt.Sprintf("%s.incident_custom_field_update.%d", key, ui)]
+			if !ok {
+				continue
+			}
+			field, err := c.findIncidentCustomField(ctx, "", name)
+			if err != nil {
+				return fmt.Errorf("Error resolving incident custom field %q: %w", name, err)
+			}
+			if field == nil {
+				return fmt.Errorf("Unable to locate any incident custom field with the name: %s", name)
+			}
+			u.ID = field.ID
+		}
+		return nil
+	}
+
+	for si, s := range p.Sets {
+		for ri, r := range s.Rules {
+			if err := resolve(fmt.Sprintf("set.%d.rule.%d.actions.0", si, ri), r.Actions); err != nil {
+				return err
+			}
+		}
+	}
+	if p.CatchAll != nil {
+		return resolve("catch_all.0.actions.0", p.CatchAll.Actions)
+	}
+
+	return nil
+}
+
+// keepEventOrchestrationPathIncidentCustomFieldNames sets the names of the
+// incident custom fields, as they were set before the Event Orchestration
+// Path was read, back on the incident_custom_field_update actions still
+// updating the same fields, since the API only returns their IDs.
+func keepEventOrchestrationPathIncidentCustomFieldNames(ctx context.Context, c *Config, d *schema.ResourceData, names map[string]string) {
+	if len(names) == 0 {
+		return
+	}
+
+	sets, _ := d.Get("set").([]interface{})
+	catchAll, _ := d.Get("catch_all").([]interface{})
+	forEachEventOrchestrationPathActions(sets, catchAll, func(key string, actions map[string]interface{}) {
+		updates, _ := actions["incident_custom_field_update"].([]interface{})
+		for ui, u := range updates {
+			update, _ := u.(map[string]interface{})
+			name, ok := names[fmt.Sprintf("%s.incident_custom_field_update.%d", key, ui)]
+			if id, _ := update["id"].(string); ok && c.incidentCustomFieldNameMatchesID(ctx, name, id) {
+				update["name"] = name
+			}
+		}
+	})
+
+	d.Set("set", sets)
+	d.Set("catch_all", catchAll)
+}
+
+// checkEventOrchestrationPathIncidentCustomFields checks at plan time that the
+// incident_custom_field_update actions of an Event Orchestration Path set
+// exactly one of the id or the name of a field, and that the values set on the
+// single value fields match their data type. Values using templates are only
+// known when the event is processed and are left to the API. Only the changed
+// actions have their value checked.
+func checkEventOrchestrationPathIncidentCustomFields(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := checkEventOrchestrationPathIncidentCustomFieldRefs(diff.GetRawConfig()); err != nil {
+		return err
+	}
+
+	c, ok := meta.(*Config)
+	if !ok {
+		return nil
+	}
+
+	sets, _ := diff.Get("set").([]interface{})
+	catchAll, _ := diff.Get("catch_all").([]interface{})
+
+	var err error
+	forEachEventOrchestrationPathActions(sets, catchAll, func(key string, actions map[string]interface{}) {
+		updates, _ := actions["incident_custom_field_update"].([]interface{})
+		for ui, u := range updates {
+			k := fmt.Sprintf("%s.incident_custom_field_update.%d", key, ui)
+			if err != nil || !diff.HasChange(k) {
+				continue
+			}
+			err = checkEventOrchestrationPathIncidentCustomFieldValue(ctx, c, diff, k, u)
+		}
+	})
+
+	return err
+}
+
+func checkEventOrchestrationPathIncidentCustomFieldRefs(config cty.Value) error {
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	check := func(key string, actions cty.Value) error {
+		if actions.IsNull() || !actions.IsKnown() || actions.LengthInt() == 0 {
+			return nil
+		}
+		action := actions.AsValueSlice()[0]
+		if action.IsNull() || !action.IsKnown() {
+			return nil
+		}
+		updates := action.GetAttr("incident_custom_field_update")
+		if updates.IsNull() || !updates.IsKnown() {
+			return nil
+		}
+		for ui, u := range updates.AsValueSlice() {
+			if u.IsNull() || !u.IsKnown() {
+				continue
+			}
+			id, name := u.GetAttr("id"), u.GetAttr("name")
+			if !id.IsKnown() || !name.IsKnown() {
+				continue
+			}
+			if id.IsNull() == name.IsNull() {
+				return fmt.Errorf("%s.incident_custom_field_update.%d: exactly one of the id or the name of the incident custom field must be set", key, ui)
+			}
+		}
+		return nil
+	}
+
+	if sets := config.GetAttr("set"); !sets.IsNull() && sets.IsKnown() {
+		for si, s := range sets.AsValueSlice() {
+			if s.IsNull() || !s.IsKnown() {
+				continue
+			}
+			rules := s.GetAttr("rule")
+			if rules.IsNull() || !rules.IsKnown() {
+				continue
+			}
+			for ri, r := range rules.AsValueSlice() {
+				if r.IsNull() || !r.IsKnown() {
+					continue
+				}
+				if err := check(fmt.Sprintf("set.%d.rule.%d.actions.0", si, ri), r.GetAttr("actions")); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if catchAll := config.GetAttr("catch_all"); !catchAll.IsNull() && catchAll.IsKnown() && catchAll.LengthInt() > 0 {
+		if ca := catchAll.AsValueSlice()[0]; !ca.IsNull() && ca.IsKnown() {
+			return check("catch_all.0.actions.0", ca.GetAttr("actions"))
+		}
+	}
+
+	return nil
+}
+
+func checkEventOrchestrationPathIncidentCustomFieldValue(ctx context.Context, c *Config, diff *schema.ResourceDiff, key string, v interface{}) error {
+	update, _ := v.(map[string]interface{})
+	id, _ := update["id"].(string)
+	name, _ := update["name"].(string)
+	value, _ := update["value"].(string)
+
+	if !diff.NewValueKnown(key+".name") || !diff.NewValueKnown(key+".value") || strings.Contains(value, "{{") {
+		return nil
+	}
+	if name == "" && (id == "" || !diff.NewValueKnown(key+".id")) {
+		return nil
+	}
+
+	// The field may be created by the same apply, missing fields are reported
+	// when the actions are saved.
+	field, err := c.findIncidentCustomField(ctx, id, name)
+	if err != nil || field == nil || field.FieldType.IsMultiValue() || !field.DataType.IsKnown() {
+		return nil
+	}
+
+	return validateIncidentCustomFieldValue(value, field.DataType, false, func() error {
+		return fmt.Errorf("%s.value: %q is not a valid value for the incident custom field %s of data type %s", key, value, field.Name, field.DataType)
+	})
+}
@@ -0,0 +1,239 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestEventOrchestrationPathServiceIncidentCustomFieldByName(t *testing.T) {
+	var saved []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/incidents/custom_fields":
+			w.Write([]byte(`{"fields":[
+				{"id":"PCF0001","name":"environment","data_type":"string","field_type":"single_value"},
+				{"id":"PCF0002","name":"impacted_hosts","data_type":"integer","field_type":"single_value"}
+			]}`))
+		case r.URL.Path == "/event_orchestrations/services/PSVC123" && r.Method == http.MethodPut:
+			var payload pagerduty.EventOrchestrationPathPayload
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("unexpected error decoding the orchestration path: %v", err)
+			}
+			payload.OrchestrationPath.Parent = &pagerduty.EventOrchestrationPathReference{ID: "PSVC123", Type: "service_reference"}
+			saved, _ = json.Marshal(payload)
+			w.Write(saved)
+		case r.URL.Path == "/event_orchestrations/services/PSVC123":
+			w.Write(saved)
+		case r.URL.Path == "/event_orchestrations/services/PSVC123/active":
+			w.Write([]byte(`{"active":true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := resourcePagerDutyEventOrchestrationPathService()
+	d := r.TestResourceData()
+	d.Set("service", "PSVC123")
+	d.Set("set", []interface{}{map[string]interface{}{
+		"id": "start",
+		"rule": []interface{}{map[string]interface{}{
+			"actions": []interface{}{map[string]interface{}{
+				"incident_custom_field_update": []interface{}{
+					map[string]interface{}{"name": "impacted_hosts", "value": "3"},
+					map[string]interface{}{"id": "PCF0001", "value": "production"},
+				},
+			}},
+		}},
+	}})
+	d.Set("catch_all", []interface{}{map[string]interface{}{
+		"actions": []interface{}{map[string]interface{}{}},
+	}})
+
+	if diags := resourcePagerDutyEventOrchestrationPathServiceUpdate(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	var payload pagerduty.EventOrchestrationPathPayload
+	if err := json.Unmarshal(saved, &payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	updates := payload.OrchestrationPath.Sets[0].Rules[0].Actions.IncidentCustomFieldUpdates
+	if len(updates) != 2 || updates[0].ID != "PCF0002" || updates[1].ID != "PCF0001" {
+		t.Fatalf("expected the custom fields to be sent by ID, got %v", payload.OrchestrationPath.Sets[0].Rules[0].Actions)
+	}
+
+	check := func(when string) {
+		expected := map[string]string{
+			"set.0.rule.0.actions.0.incident_custom_field_update.0.id":    "PCF0002",
+			"set.0.rule.0.actions.0.incident_custom_field_update.0.name":  "impacted_hosts",
+			"set.0.rule.0.actions.0.incident_custom_field_update.0.value": "3",
+			"set.0.rule.0.actions.0.incident_custom_field_update.1.id":    "PCF0001",
+			"set.0.rule.0.actions.0.incident_custom_field_update.1.name":  "",
+		}
+		for k, v := range expected {
+			if got := d.Get(k); got != v {
+				t.Errorf("expected %s to be %q after the %s, got %q", k, v, when, got)
+			}
+		}
+	}
+	check("update")
+
+	d = r.Data(d.State())
+	if diags := resourcePagerDutyEventOrchestrationPathServiceRead(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	check("read")
+}
+
+func TestEventOrchestrationPathServiceIncidentCustomFieldByName_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/incidents/custom_fields" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"fields":[{"id":"PCF0001","name":"environment","data_type":"string","field_type":"single_value"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := resourcePagerDutyEventOrchestrationPathService()
+	d := r.TestResourceData()
+	d.Set("service", "PSVC123")
+	d.Set("catch_all", []interface{}{map[string]interface{}{
+		"actions": []interface{}{map[string]interface{}{
+			"incident_custom_field_update": []interface{}{
+				map[string]interface{}{"name": "not_a_field", "value": "foo"},
+			},
+		}},
+	}})
+
+	diags := resourcePagerDutyEventOrchestrationPathServiceUpdate(context.Background(), d, config)
+	if !diags.HasError() {
+		t.Fatal("expected an error setting a missing custom field")
+	}
+	if got, expected := diags[0].Summary, "Unable to locate any incident custom field with the name: not_a_field"; got != expected {
+		t.Errorf("expected the error %q, got %q", expected, got)
+	}
+}
+
+func TestCheckEventOrchestrationPathIncidentCustomFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"fields":[
+			{"id":"PCF0001","name":"environment","data_type":"string","field_type":"single_value"},
+			{"id":"PCF0002","name":"impacted_hosts","data_type":"integer","field_type":"single_value"},
+			{"id":"PCF0003","name":"regions","data_type":"string","field_type":"multi_value"}
+		]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	cases := []struct {
+		name   string
+		update map[string]interface{}
+		err    string
+	}{
+		{name: "valid value by name", update: map[string]interface{}{"name": "impacted_hosts", "value": "3"}},
+		{name: "valid value by id", update: map[string]interface{}{"id": "PCF0001", "value": "production"}},
+		{name: "template", update: map[string]interface{}{"name": "impacted_hosts", "value": "{{variables.hosts}}"}},
+		{name: "multi value field", update: map[string]interface{}{"name": "regions", "value": "us-east-1"}},
+		{name: "field created by the same apply", update: map[string]interface{}{"name": "not_yet_a_field", "value": "foo"}},
+		{
+			name:   "invalid value by name",
+			update: map[string]interface{}{"name": "impacted_hosts", "value": "many"},
+			err:    `catch_all.0.actions.0.incident_custom_field_update.0.value: "many" is not a valid value for the incident custom field impacted_hosts of data type integer`,
+		},
+		{
+			name:   "invalid value by id",
+			update: map[string]interface{}{"id": "PCF0002", "value": "3.5"},
+			err:    `catch_all.0.actions.0.incident_custom_field_update.0.value: "3.5" is not a valid value for the incident custom field impacted_hosts of data type integer`,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			raw := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"service": "PSVC123",
+				"set":     []interface{}{map[string]interface{}{"id": "start"}},
+				"catch_all": []interface{}{map[string]interface{}{
+					"actions": []interface{}{map[string]interface{}{
+						"incident_custom_field_update": []interface{}{c.update},
+					}},
+				}},
+			})
+
+			_, err := resourcePagerDutyEventOrchestrationPathService().Diff(context.Background(), nil, raw, config)
+			if c.err == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if c.err != "" && (err == nil || err.Error() != c.err) {
+				t.Errorf("expected the error %q, got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestCheckEventOrchestrationPathIncidentCustomFieldRefs(t *testing.T) {
+	update := func(id, name cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"id": id, "name": name, "value": cty.StringVal("foo")})
+	}
+	config := func(u cty.Value) cty.Value {
+		actions := cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"incident_custom_field_update": cty.ListVal([]cty.Value{u}),
+		})})
+		return cty.ObjectVal(map[string]cty.Value{
+			"set": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"rule": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"actions": actions})}),
+			})}),
+			"catch_all": cty.ListValEmpty(cty.DynamicPseudoType),
+		})
+	}
+
+	cases := []struct {
+		name   string
+		update cty.Value
+		err    bool
+	}{
+		{name: "id", update: update(cty.StringVal("PCF0001"), cty.NullVal(cty.String))},
+		{name: "name", update: update(cty.NullVal(cty.String), cty.StringVal("environment"))},
+		{name: "unknown id", update: update(cty.UnknownVal(cty.String), cty.NullVal(cty.String))},
+		{name: "neither", update: update(cty.NullVal(cty.String), cty.NullVal(cty.String)), err: true},
+		{name: "both", update: update(cty.StringVal("PCF0001"), cty.StringVal("environment")), err: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkEventOrchestrationPathIncidentCustomFieldRefs(config(c.update))
+			if c.err && (err == nil || err.Error() != "set.0.rule.0.actions.0.incident_custom_field_update.0: exactly one of the id or the name of the incident custom field must be set") {
+				t.Errorf("expected an error about the id and the name, got %v", err)
+			}
+			if !c.err && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package pagerduty
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -22,8 +24,9 @@ var eventOrchestrationPathVariablesSchema = map[string]*schema.Schema{
 		Required: true,
 	},
 	"path": {
-		Type:     schema.TypeString,
-		Required: true,
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: validateEventOrchestrationPathFieldPath(false),
 	},
 	"type": {
 		Type:     schema.TypeString,
@@ -41,8 +44,9 @@ var eventOrchestrationPathExtractionsSchema = map[string]*schema.Schema{
 		Optional: true,
 	},
 	"source": {
-		Type:     schema.TypeString,
-		Optional: true,
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validateEventOrchestrationPathFieldPath(true),
 	},
 	"target": {
 		Type:     schema.TypeString,
@@ -54,6 +58,26 @@ var eventOrchestrationPathExtractionsSchema = map[string]*schema.Schema{
 	},
 }
 
+// eventOrchestrationPathVariableActionSchema and
+// eventOrchestrationPathExtractionActionSchema are the variable and extraction
+// actions of the rules of all the orchestration paths, so the actions can be
+// moved between the paths as they are.
+var eventOrchestrationPathVariableActionSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	Elem: &schema.Resource{
+		Schema: eventOrchestrationPathVariablesSchema,
+	},
+}
+
+var eventOrchestrationPathExtractionActionSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	Elem: &schema.Resource{
+		Schema: eventOrchestrationPathExtractionsSchema,
+	},
+}
+
 var eventOrchestrationAutomationActionObjectSchema = map[string]*schema.Schema{
 	"key": {
 		Type:     schema.TypeString,
@@ -68,7 +92,12 @@ var eventOrchestrationAutomationActionObjectSchema = map[string]*schema.Schema{
 var eventOrchestrationIncidentCustomFieldsObjectSchema = map[string]*schema.Schema{
 	"id": {
 		Type:     schema.TypeString,
-		Required: true,
+		Optional: true,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Optional: true,
 	},
 	"value": {
 		Type:     schema.TypeString,
@@ -122,6 +151,25 @@ func invalidExtractionRegexTemplateValConfig() string {
 		}`
 }
 
+func invalidExtractionTemplateSourceConfig() string {
+	return `
+		extraction {
+			template = "hi"
+			source = "event.summary"
+			target = "event.summary"
+		}`
+}
+
+func invalidVariablePathConfig() string {
+	return `
+		variable {
+			name = "hostname"
+			path = "source"
+			type = "regex"
+			value = "(.*)"
+		}`
+}
+
 func invalidExtractionRegexNilSourceConfig() string {
 	return `
 		extraction {
@@ -130,6 +178,36 @@ func invalidExtractionRegexNilSourceConfig() string {
 		}`
 }
 
+// validateEventOrchestrationPathFieldPath checks that a value is a PCL path to
+// a field of the event, like `event.summary` or `raw_event.fieldname`. When
+// withVariables is set, paths to previously defined variables, like
+// `variables.hostname`, are accepted too.
+func validateEventOrchestrationPathFieldPath(withVariables bool) schema.SchemaValidateDiagFunc {
+	prefixes := []string{"event.", "raw_event."}
+	if withVariables {
+		prefixes = append(prefixes, "variables.")
+	}
+
+	return func(v interface{}, p cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		value := v.(string)
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(value, prefix) && len(value) > len(prefix) {
+				return diags
+			}
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("%q is not a valid path to an event field", value),
+			Detail:        fmt.Sprintf("The path must start with one of %q, e.g. \"event.summary\" for the summary field of PD-CEF events.", prefixes),
+			AttributePath: p,
+		})
+		return diags
+	}
+}
+
 func validateEventOrchestrationPathSeverity() schema.SchemaValidateDiagFunc {
 	return validateValueDiagFunc([]string{
 		"info",
@@ -179,6 +257,9 @@ func checkExtractionAttributes(diff *schema.ResourceDiff, loc string) error {
 		if r != "" && s == "" {
 			return fmt.Errorf("Invalid configuration in %s: source can't be blank", prefix)
 		}
+		if t != "" && s != "" {
+			return fmt.Errorf("Invalid configuration in %s: source can only be used with regex", prefix)
+		}
 	}
 	return nil
 }
@@ -246,6 +327,25 @@ func flattenEventOrchestrationPathVariables(v []*pagerduty.EventOrchestrationPat
 	return res
 }
 
+// expandEventOrchestrationPathVariablesAndExtractions sets the variable and
+// extraction actions of the actions a of a rule of any orchestration path.
+func expandEventOrchestrationPathVariablesAndExtractions(a map[string]interface{}, actions *pagerduty.EventOrchestrationPathRuleActions) {
+	actions.Variables = expandEventOrchestrationPathVariables(a["variable"])
+	actions.Extractions = expandEventOrchestrationPathExtractions(a["extraction"])
+}
+
+// flattenEventOrchestrationPathVariablesAndExtractions sets the variable and
+// extraction actions of the flattened actions of a rule of any orchestration
+// path.
+func flattenEventOrchestrationPathVariablesAndExtractions(actions *pagerduty.EventOrchestrationPathRuleActions, flattenedAction map[string]interface{}) {
+	if actions.Variables != nil {
+		flattenedAction["variable"] = flattenEventOrchestrationPathVariables(actions.Variables)
+	}
+	if actions.Extractions != nil {
+		flattenedAction["extraction"] = flattenEventOrchestrationPathExtractions(actions.Extractions)
+	}
+}
+
 func expandEventOrchestrationPathIncidentCustomFields(v interface{}) []*pagerduty.EventOrchestrationPathIncidentCustomFieldUpdate {
 	res := []*pagerduty.EventOrchestrationPathIncidentCustomFieldUpdate{}
 
@@ -409,7 +509,7 @@ func emptyOrchestrationPathStructBuilder(pathType string) *pagerduty.EventOrches
 		return &pagerduty.EventOrchestrationPath{
 			CatchAll: &pagerduty.EventOrchestrationPathCatchAll{
 				Actions: &pagerduty.EventOrchestrationPathRuleActions{
-					RouteTo: "unrouted",
+					RouteTo: catchAllUnrouted,
 				},
 			},
 			Sets: []*pagerduty.EventOrchestrationPathSet{
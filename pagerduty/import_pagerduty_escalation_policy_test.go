@@ -2,6 +2,8 @@ package pagerduty
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -30,3 +32,93 @@ func TestAccPagerDutyEscalationPolicy_import(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPagerDutyEscalationPolicy_importWithTeams(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyWithTeamsConfig(username, email, team, escalationPolicy),
+			},
+
+			{
+				ResourceName:      "pagerduty_escalation_policy.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// The imported policy mustn't plan any change to its teams.
+				Config:   testAccCheckPagerDutyEscalationPolicyWithTeamsConfig(username, email, team, escalationPolicy),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourcePagerDutyEscalationPolicyImport_DefaultTeam(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/escalation_policies/PEP1234" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+			return
+		}
+		w.Write([]byte(`{"escalation_policy":{
+			"id":"PEP1234",
+			"name":"foo",
+			"num_loops":0,
+			"teams":[{"id":"PTEAM12","type":"team_reference"}],
+			"escalation_rules":[{"id":"PRULE12","escalation_delay_in_minutes":10,"targets":[{"id":"PUSER01","type":"user_reference"}]}]
+		}}`))
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		name        string
+		defaultTeam string
+	}{
+		{name: "without default team"},
+		{name: "team is the default team", defaultTeam: "PTEAM12"},
+		{name: "other default team", defaultTeam: "PTEAM34"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config := &Config{
+				Token:               "foo",
+				ApiUrlOverride:      server.URL,
+				SkipCredsValidation: true,
+				DefaultTeam:         c.defaultTeam,
+			}
+
+			r := resourcePagerDutyEscalationPolicy()
+			d := r.TestResourceData()
+			d.SetId("PEP1234")
+			imported, err := r.Importer.State(d, config)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(imported) != 1 {
+				t.Fatalf("expected a single escalation policy to be imported, got %d", len(imported))
+			}
+			d = imported[0]
+			if err := r.Read(d, config); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			teams := d.Get("teams").([]interface{})
+			if len(teams) != 1 || teams[0] != "PTEAM12" {
+				t.Errorf("expected the imported escalation policy to keep its team PTEAM12, got %v", teams)
+			}
+			if got := d.Get("rule.0.target.0.id"); got != "PUSER01" {
+				t.Errorf("expected the rules of the imported escalation policy to be read, got a target %v", got)
+			}
+		})
+	}
+}
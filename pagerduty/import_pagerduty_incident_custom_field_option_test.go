@@ -0,0 +1,58 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestAccPagerDutyIncidentCustomFieldOption_import(t *testing.T) {
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+	fieldOptionValue := fmt.Sprintf("tf_%s", acctest.RandString(5))
+	dataType := pagerduty.IncidentCustomFieldDataTypeString
+	var fieldID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentCustomFieldTests(t)
+
+			field := testAccCreateTestPagerDutyIncidentCustomFieldForFieldOption(fieldName, dataType)
+			fieldID = field.ID
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(state *terraform.State) error {
+			if err := testAccCheckPagerDutyIncidentCustomFieldOptionDestroy(state); err != nil {
+				return err
+			}
+			return testAccDeleteTestPagerDutyIncidentCustomFieldForFieldOption(fieldID)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldOptionConfig(fieldName, dataType, fieldOptionValue),
+			},
+			{
+				ResourceName:      "pagerduty_incident_custom_field_option.test",
+				ImportStateIdFunc: testAccCheckPagerDutyIncidentCustomFieldOptionID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "pagerduty_incident_custom_field_option.test",
+				ImportStateId: "PNOFIELD",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile("Expecting an ID formed as '<field_id>:<field_option_id>'"),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentCustomFieldOptionID(s *terraform.State) (string, error) {
+	rs := s.RootModule().Resources["pagerduty_incident_custom_field_option.test"]
+	return fmt.Sprintf("%v:%v", rs.Primary.Attributes["field"], rs.Primary.ID), nil
+}
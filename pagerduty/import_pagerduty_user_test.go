@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccPagerDutyUser_import(t *testing.T) {
@@ -29,3 +30,62 @@ func TestAccPagerDutyUser_import(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPagerDutyUser_importJobTitleAndDescription(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	jobTitle := fmt.Sprintf("tf-%s-title", username)
+	description := fmt.Sprintf("tf-%s-description", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserConfigJobTitleAndDescription(username, email, jobTitle, description),
+			},
+
+			{
+				ResourceName:      "pagerduty_user.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported user, got %d", len(states))
+					}
+					if v := states[0].Attributes["job_title"]; v != jobTitle {
+						return fmt.Errorf("expected job_title to be %q, got %q", jobTitle, v)
+					}
+					if v := states[0].Attributes["description"]; v != description {
+						return fmt.Errorf("expected description to be %q, got %q", description, v)
+					}
+					return nil
+				},
+			},
+
+			{
+				Config: testAccCheckPagerDutyUserConfigJobTitleAndDescription(username, email, "", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_user.foo", "job_title", ""),
+					resource.TestCheckResourceAttr("pagerduty_user.foo", "description", ""),
+				),
+			},
+
+			{
+				Config:   testAccCheckPagerDutyUserConfigJobTitleAndDescription(username, email, "", ""),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyUserConfigJobTitleAndDescription(username, email, jobTitle, description string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%s"
+  email       = "%s"
+  job_title   = "%s"
+  description = "%s"
+}`, username, email, jobTitle, description)
+}
@@ -1,9 +1,12 @@
 package pagerduty
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"math"
+	"net/http"
 	"net/url"
 	"reflect"
 	"strconv"
@@ -11,8 +14,10 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 func validateIncidentCustomFieldDataType() schema.SchemaValidateDiagFunc {
@@ -156,6 +161,90 @@ func convertIncidentCustomFieldValueForFlatten(value interface{}, multiValue boo
 			return string(b), nil
 		}
 	} else {
+		// Numbers are decoded from the API responses as float64, format them
+		// without exponent so large integers read back as they were written.
+		if f, ok := value.(float64); ok {
+			return strconv.FormatFloat(f, 'f', -1, 64), nil
+		}
 		return fmt.Sprintf("%v", value), nil
 	}
 }
+
+// listIncidentCustomFields returns the incident custom fields of the account.
+// The list is only requested once per provider run and then served from
+// memory, unless refresh is set.
+func (c *Config) listIncidentCustomFields(ctx context.Context, refresh bool) ([]*pagerduty.IncidentCustomField, error) {
+	c = c.root()
+	c.incidentCustomFieldsMu.Lock()
+	defer c.incidentCustomFieldsMu.Unlock()
+
+	if c.incidentCustomFields != nil && !refresh {
+		return c.incidentCustomFields, nil
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []*pagerduty.IncidentCustomField
+	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		resp, _, err := client.IncidentCustomFields.ListContext(ctx, nil)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusForbidden) {
+				return retry.NonRetryableError(err)
+			}
+
+			util.SleepContext(ctx, 2*time.Second)
+			return retry.RetryableError(err)
+		}
+		fields = resp.Fields
+		return nil
+	})
+	if retryErr != nil {
+		return nil, retryErr
+	}
+
+	if fields == nil {
+		fields = []*pagerduty.IncidentCustomField{}
+	}
+	c.incidentCustomFields = fields
+
+	return c.incidentCustomFields, nil
+}
+
+// findIncidentCustomField returns the incident custom field of the account
+// with the ID, or with the name when name is set, and nil when there is none.
+// The cached list is refreshed once before reporting a missing field, so the
+// fields created earlier in the same run are found.
+func (c *Config) findIncidentCustomField(ctx context.Context, id, name string) (*pagerduty.IncidentCustomField, error) {
+	for _, refresh := range []bool{false, true} {
+		fields, err := c.listIncidentCustomFields(ctx, refresh)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range fields {
+			if (name != "" && f.Name == name) || (name == "" && f.ID == id) {
+				return f, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// incidentCustomFieldNameMatchesID reports whether the incident custom field
+// with the name is the one identified by id.
+func (c *Config) incidentCustomFieldNameMatchesID(ctx context.Context, name, id string) bool {
+	if name == "" || id == "" {
+		return false
+	}
+
+	field, err := c.findIncidentCustomField(ctx, "", name)
+	if err != nil {
+		log.Printf("[WARN] Error resolving incident custom field %q: %s", name, err)
+		return false
+	}
+
+	return field != nil && field.ID == id
+}
@@ -45,6 +45,11 @@ func TestPagerDutyIncidentCustomField_ConvertDefaultValueForFlatten(t *testing.T
 		t.Errorf("Unexpected flatten int value")
 	}
 
+	v, _ = convertIncidentCustomFieldValueForFlatten(float64(1000000), false)
+	if v != "1000000" {
+		t.Errorf("Unexpected flatten int value decoded as float")
+	}
+
 	v, _ = convertIncidentCustomFieldValueForFlatten([]int{5, 6}, true)
 	if v != "[5,6]" {
 		t.Errorf("Unexpected flatten []int value")
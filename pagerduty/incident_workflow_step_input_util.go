@@ -0,0 +1,163 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
+)
+
+// incidentWorkflowAction is an action incident workflow steps can run, with
+// the inputs it accepts as its metadata lists them.
+type incidentWorkflowAction struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Metadata struct {
+		Inputs []incidentWorkflowActionInputSchema `json:"inputs"`
+	} `json:"metadata"`
+}
+
+type incidentWorkflowActionInputSchema struct {
+	Name          string      `json:"name"`
+	ParameterType string      `json:"parameter_type"`
+	Required      bool        `json:"required"`
+	DefaultValue  interface{} `json:"default_value"`
+}
+
+// fetchIncidentWorkflowAction gets an action of the incident workflows, the
+// API client has no method for the endpoint. The actions found are cached
+// for the rest of the provider run.
+func fetchIncidentWorkflowAction(ctx context.Context, c *Config, id string) (*incidentWorkflowAction, error) {
+	if cached, ok := c.cachedReference("incident_workflow_action", id); ok {
+		return cached.(*incidentWorkflowAction), nil
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	var action *incidentWorkflowAction
+	err = retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		var v struct {
+			Action *incidentWorkflowAction `json:"action"`
+		}
+		if err := getAPIResource(ctx, client, fmt.Sprintf("/incident_workflows/actions/%s", url.PathEscape(id)), nil, &v); err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(err)
+			}
+
+			util.SleepContext(ctx, 2*time.Second)
+			return retry.RetryableError(err)
+		}
+		action = v.Action
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if action == nil {
+		return nil, fmt.Errorf("the incident workflow action %s has no definition", id)
+	}
+
+	c.cacheReference("incident_workflow_action", id, action)
+	return action, nil
+}
+
+// checkIncidentWorkflowStepInputs checks the inputs of the steps against the
+// inputs their actions accept when validate_inputs is set, so a misspelled or
+// missing input fails the plan naming the step instead of failing the apply.
+func checkIncidentWorkflowStepInputs(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("validate_inputs").(bool) || !diff.NewValueKnown("step") {
+		return nil
+	}
+	c, ok := meta.(*Config)
+	if !ok {
+		return nil
+	}
+
+	steps, _ := diff.Get("step").([]interface{})
+	return checkIncidentWorkflowStepsInputs(diff, "step", steps, func(id string) (*incidentWorkflowAction, error) {
+		return fetchIncidentWorkflowAction(ctx, c, id)
+	})
+}
+
+func checkIncidentWorkflowStepsInputs(diff *schema.ResourceDiff, prefix string, steps []interface{}, action func(string) (*incidentWorkflowAction, error)) error {
+	for i, raw := range steps {
+		step, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key := fmt.Sprintf("%s.%d", prefix, i)
+
+		actionID, _ := step["action"].(string)
+		if actionID != "" && diff.NewValueKnown(key+".action") {
+			a, err := action(actionID)
+			if err != nil {
+				return fmt.Errorf("%s.action: error reading the inputs of the incident workflow action %s: %w", key, actionID, err)
+			}
+			if err := checkIncidentWorkflowStepInputNames(key, step, a); err != nil {
+				return err
+			}
+		}
+
+		inlineStepsInputs, _ := step["inline_steps_input"].([]interface{})
+		for j, rawInput := range inlineStepsInputs {
+			input, ok := rawInput.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			inlineSteps, _ := input["step"].([]interface{})
+			if err := checkIncidentWorkflowStepsInputs(diff, fmt.Sprintf("%s.inline_steps_input.%d.step", key, j), inlineSteps, action); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkIncidentWorkflowStepInputNames checks every input of the step is an
+// input of the action, and every required input of the action without a
+// default value is set.
+func checkIncidentWorkflowStepInputNames(key string, step map[string]interface{}, action *incidentWorkflowAction) error {
+	accepted := make(map[string]bool, len(action.Metadata.Inputs))
+	names := make([]string, 0, len(action.Metadata.Inputs))
+	for _, in := range action.Metadata.Inputs {
+		accepted[in.Name] = true
+		names = append(names, in.Name)
+	}
+	sort.Strings(names)
+
+	set := map[string]bool{}
+	for _, attr := range []string{"input", "inline_steps_input"} {
+		inputs, _ := step[attr].([]interface{})
+		for j, raw := range inputs {
+			input, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := input["name"].(string)
+			if name == "" {
+				continue
+			}
+			if !accepted[name] {
+				return fmt.Errorf("%s.%s.%d.name: %q is not an input of the incident workflow action %s, the inputs of the action are: %s", key, attr, j, name, action.ID, strings.Join(names, ", "))
+			}
+			set[name] = true
+		}
+	}
+
+	for _, in := range action.Metadata.Inputs {
+		if in.Required && in.DefaultValue == nil && !set[in.Name] {
+			return fmt.Errorf("%s: the input %q required by the incident workflow action %s is missing", key, in.Name, action.ID)
+		}
+	}
+	return nil
+}
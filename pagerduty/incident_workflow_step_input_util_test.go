@@ -0,0 +1,121 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCheckIncidentWorkflowStepInputs(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		requests++
+		switch r.URL.Path {
+		case "/incident_workflows/actions/pagerduty.com:incident-workflows:send-status-update:1":
+			w.Write([]byte(`{"action":{"id":"pagerduty.com:incident-workflows:send-status-update:1","name":"Send Status Update","metadata":{"inputs":[
+				{"name":"Message","parameter_type":"text","required":true},
+				{"name":"Status Update template","parameter_type":"text","required":true,"default_value":"Default"}
+			]}}}`))
+		case "/incident_workflows/actions/pagerduty.com:logic:if-then:1":
+			w.Write([]byte(`{"action":{"id":"pagerduty.com:logic:if-then:1","name":"If-then","metadata":{"inputs":[
+				{"name":"Condition","parameter_type":"text","required":true},
+				{"name":"Then","parameter_type":"inline_steps","required":false}
+			]}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+		}
+	}))
+	defer server.Close()
+
+	input := func(name, value string) map[string]interface{} {
+		return map[string]interface{}{"name": name, "value": value}
+	}
+	statusUpdate := func(inputs ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"name":   "Send status update",
+			"action": "pagerduty.com:incident-workflows:send-status-update:1",
+			"input":  inputs,
+		}
+	}
+
+	cases := []struct {
+		name     string
+		validate bool
+		steps    []interface{}
+		err      string
+	}{
+		{
+			name:     "valid",
+			validate: true,
+			steps:    []interface{}{statusUpdate(input("Message", "Investigating"))},
+		},
+		{
+			name:  "not validated",
+			steps: []interface{}{statusUpdate(input("Mesage", "Investigating"))},
+		},
+		{
+			name:     "misspelled input",
+			validate: true,
+			steps:    []interface{}{statusUpdate(input("Message", "Investigating"), input("Template", "Default"))},
+			err:      `step.0.input.1.name: "Template" is not an input of the incident workflow action pagerduty.com:incident-workflows:send-status-update:1, the inputs of the action are: Message, Status Update template`,
+		},
+		{
+			name:     "missing required input",
+			validate: true,
+			steps:    []interface{}{statusUpdate(input("Status Update template", "Default"))},
+			err:      `step.0: the input "Message" required by the incident workflow action pagerduty.com:incident-workflows:send-status-update:1 is missing`,
+		},
+		{
+			name:     "missing required input of an inline step",
+			validate: true,
+			steps: []interface{}{map[string]interface{}{
+				"name":   "If",
+				"action": "pagerduty.com:logic:if-then:1",
+				"input":  []interface{}{input("Condition", "incident.priority matches 'P1'")},
+				"inline_steps_input": []interface{}{map[string]interface{}{
+					"name": "Then",
+					"step": []interface{}{statusUpdate()},
+				}},
+			}},
+			err: `step.0.inline_steps_input.0.step.0: the input "Message" required by the incident workflow action pagerduty.com:incident-workflows:send-status-update:1 is missing`,
+		},
+		{
+			name:     "unknown action",
+			validate: true,
+			steps:    []interface{}{map[string]interface{}{"name": "Nope", "action": "pagerduty.com:nope:1"}},
+			err:      "step.0.action: error reading the inputs of the incident workflow action pagerduty.com:nope:1",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config := &Config{
+				Token:               "foo",
+				ApiUrlOverride:      server.URL,
+				SkipCredsValidation: true,
+			}
+			requests = 0
+
+			raw := sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":            "Example",
+				"validate_inputs": c.validate,
+				"step":            c.steps,
+			})
+			_, err := resourcePagerDutyIncidentWorkflow().Diff(context.Background(), nil, raw, config)
+			if c.err == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if c.err != "" && (err == nil || !strings.HasPrefix(err.Error(), c.err)) {
+				t.Errorf("expected the error %q, got %v", c.err, err)
+			}
+			if !c.validate && requests > 0 {
+				t.Errorf("expected the inputs not to be checked without validate_inputs, got %d requests", requests)
+			}
+		})
+	}
+}
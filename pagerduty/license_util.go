@@ -0,0 +1,118 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// listLicenses returns the licenses available for the account. The list is
+// only requested once per provider run and then served from memory.
+func (c *Config) listLicenses() ([]*pagerduty.License, error) {
+	c = c.root()
+	c.licensesMu.Lock()
+	defer c.licensesMu.Unlock()
+
+	if c.licenses != nil {
+		return c.licenses, nil
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	var licenses []*pagerduty.License
+	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+		resp, _, err := client.Licenses.List()
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusForbidden) {
+				return retry.NonRetryableError(err)
+			}
+
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+		licenses = resp
+		return nil
+	})
+	if retryErr != nil {
+		return nil, retryErr
+	}
+
+	if licenses == nil {
+		licenses = []*pagerduty.License{}
+	}
+	c.licenses = licenses
+
+	return c.licenses, nil
+}
+
+// resolveLicenseID returns the ID of the license referenced by ref, which can
+// be either a license ID or a license name. IDs are looked up first, so a
+// license named like an ID can still be referenced by its name. Names are
+// matched case insensitively and must match exactly one license of the
+// account.
+func (c *Config) resolveLicenseID(ref string) (string, error) {
+	if ref == "" {
+		return ref, nil
+	}
+
+	licenses, err := c.listLicenses()
+	if err != nil {
+		return "", fmt.Errorf("Error resolving license %q: %w", ref, err)
+	}
+
+	for _, l := range licenses {
+		if l.ID == ref {
+			return l.ID, nil
+		}
+	}
+
+	var matches []*pagerduty.License
+	for _, l := range licenses {
+		if strings.EqualFold(l.Name, ref) {
+			matches = append(matches, l)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("Unable to locate any license with the name: %s", ref)
+	case 1:
+		log.Printf("[DEBUG] Resolved license %q to %s", ref, matches[0].ID)
+		return matches[0].ID, nil
+	}
+
+	return "", fmt.Errorf("License name %q matches %d licenses, please reference the license by its ID instead", ref, len(matches))
+}
+
+// licenseRefMatchesID reports whether ref, given either as a license ID or a
+// license name, references the license identified by id.
+func (c *Config) licenseRefMatchesID(ref, id string) bool {
+	if ref == id {
+		return true
+	}
+	if ref == "" || id == "" {
+		return false
+	}
+
+	resolved, err := c.resolveLicenseID(ref)
+	if err != nil {
+		log.Printf("[WARN] %s", err)
+		return false
+	}
+
+	return resolved == id
+}
+
+// isLicenseRequiredError reports whether err is the API rejecting a user
+// because no license was assigned and none could be picked automatically.
+func isLicenseRequiredError(err error) bool {
+	return isErrCode(err, http.StatusBadRequest) && strings.Contains(strings.ToLower(err.Error()), "license")
+}
@@ -0,0 +1,52 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestResourcePagerDutyResolveLicenseID(t *testing.T) {
+	c := &Config{
+		licenses: []*pagerduty.License{
+			{ID: "PAB12CD", Name: "Full User"},
+			{ID: "PAB12CE", Name: "Stakeholder"},
+			{ID: "PAB12CF", Name: "PLICENS"},
+		},
+	}
+
+	cases := []struct {
+		ref      string
+		expected string
+		fails    bool
+	}{
+		{ref: "", expected: ""},
+		{ref: "PAB12CE", expected: "PAB12CE"},
+		{ref: "full user", expected: "PAB12CD"},
+		{ref: "PLICENS", expected: "PAB12CF"},
+		{ref: "PZZ99ZZ", fails: true},
+	}
+
+	for _, tc := range cases {
+		id, err := c.resolveLicenseID(tc.ref)
+		if tc.fails {
+			if err == nil {
+				t.Errorf("%q: expected error while resolving license: got %s", tc.ref, id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error while resolving license: %s", tc.ref, err)
+		}
+		if id != tc.expected {
+			t.Errorf("%q: expected license ID to be %q: got %q", tc.ref, tc.expected, id)
+		}
+	}
+
+	if !c.licenseRefMatchesID("Stakeholder", "PAB12CE") {
+		t.Errorf("expected license name Stakeholder to match PAB12CE")
+	}
+	if c.licenseRefMatchesID("PLICENS", "PAB12CD") {
+		t.Errorf("expected license name PLICENS not to match PAB12CD")
+	}
+}
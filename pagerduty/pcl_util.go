@@ -0,0 +1,288 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// pclComparisonOperators lists the symbolic operators supported by the
+// PagerDuty Condition Language (PCL), any other combination of symbolic
+// characters is reported as an unknown operator.
+var pclComparisonOperators = []string{"==", "!=", "<", "<=", ">", ">="}
+
+// pclOperatorHints suggests the PCL counterpart of operators commonly
+// borrowed from other languages.
+var pclOperatorHints = map[string]string{
+	"&&":  "and",
+	"||":  "or",
+	"!":   "not",
+	"=":   "==",
+	"===": "==",
+	"!==": "!=",
+	"<>":  "!=",
+}
+
+type pclTokenKind int
+
+const (
+	pclTokenWord pclTokenKind = iota
+	pclTokenString
+	pclTokenOperator
+	pclTokenLParen
+	pclTokenRParen
+)
+
+type pclToken struct {
+	kind  pclTokenKind
+	value string
+}
+
+func isPCLOperatorChar(c byte) bool {
+	return strings.IndexByte("=!<>&|", c) >= 0
+}
+
+func tokenizePCLExpression(expr string) ([]pclToken, error) {
+	var tokens []pclToken
+
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, pclToken{kind: pclTokenLParen, value: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, pclToken{kind: pclTokenRParen, value: ")"})
+			i++
+		case c == '\'' || c == '"':
+			j := i + 1
+			for ; j < len(expr) && expr[j] != c; j++ {
+				if expr[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(expr) {
+				return nil, fmt.Errorf("unterminated string literal starting at position %d", i+1)
+			}
+			tokens = append(tokens, pclToken{kind: pclTokenString, value: expr[i : j+1]})
+			i = j + 1
+		case isPCLOperatorChar(c):
+			j := i
+			for j < len(expr) && isPCLOperatorChar(expr[j]) {
+				j++
+			}
+			tokens = append(tokens, pclToken{kind: pclTokenOperator, value: expr[i:j]})
+			i = j
+		default:
+			j := i
+			for j < len(expr) && !strings.ContainsRune(" \t\n\r()'\"", rune(expr[j])) && !isPCLOperatorChar(expr[j]) {
+				j++
+			}
+			tokens = append(tokens, pclToken{kind: pclTokenWord, value: expr[i:j]})
+			i = j
+		}
+	}
+
+	return tokens, nil
+}
+
+func isPCLLogicalOperator(t pclToken) bool {
+	return t.kind == pclTokenWord && (strings.EqualFold(t.value, "and") || strings.EqualFold(t.value, "or"))
+}
+
+func isPCLNot(t pclToken) bool {
+	return t.kind == pclTokenWord && strings.EqualFold(t.value, "not")
+}
+
+// endsPCLOperand reports whether t can be the last token of an operand.
+func endsPCLOperand(t pclToken) bool {
+	switch t.kind {
+	case pclTokenString, pclTokenRParen:
+		return true
+	case pclTokenWord:
+		return !isPCLLogicalOperator(t) && !isPCLNot(t)
+	}
+	return false
+}
+
+// startsPCLOperand reports whether t can be the first token of an operand.
+func startsPCLOperand(t pclToken) bool {
+	switch t.kind {
+	case pclTokenString, pclTokenLParen:
+		return true
+	case pclTokenWord:
+		return !isPCLLogicalOperator(t)
+	}
+	return false
+}
+
+// validatePCLExpression performs a lightweight syntax check of a PCL
+// expression. It isn't a full parser, it only catches gross mistakes like
+// unbalanced parentheses, unterminated strings, unknown operators or dangling
+// logical operators, which would otherwise only be reported by the API.
+func validatePCLExpression(expr string) error {
+	if strings.TrimSpace(expr) == "" {
+		return fmt.Errorf("expression can't be blank")
+	}
+
+	tokens, err := tokenizePCLExpression(expr)
+	if err != nil {
+		return err
+	}
+
+	depth := 0
+	for i, t := range tokens {
+		var prev, next *pclToken
+		if i > 0 {
+			prev = &tokens[i-1]
+		}
+		if i < len(tokens)-1 {
+			next = &tokens[i+1]
+		}
+
+		switch {
+		case t.kind == pclTokenLParen:
+			depth++
+			if next != nil && next.kind == pclTokenRParen {
+				return fmt.Errorf("empty parentheses")
+			}
+		case t.kind == pclTokenRParen:
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("unbalanced parentheses, unexpected \")\"")
+			}
+		case t.kind == pclTokenOperator:
+			if !isPCLComparisonOperator(t.value) {
+				if hint, ok := pclOperatorHints[t.value]; ok {
+					return fmt.Errorf("unknown operator %q, use %q instead", t.value, hint)
+				}
+				return fmt.Errorf("unknown operator %q", t.value)
+			}
+			if prev == nil || !endsPCLOperand(*prev) || next == nil || !startsPCLOperand(*next) || isPCLNot(*next) {
+				return fmt.Errorf("operator %q is missing an operand", t.value)
+			}
+		case isPCLLogicalOperator(t):
+			if prev == nil || !endsPCLOperand(*prev) || next == nil || !startsPCLOperand(*next) {
+				return fmt.Errorf("logical operator %q is missing an operand", t.value)
+			}
+		case isPCLNot(t):
+			if next == nil || !startsPCLOperand(*next) {
+				return fmt.Errorf("operator %q is missing an operand", t.value)
+			}
+		}
+	}
+
+	if depth > 0 {
+		return fmt.Errorf("unbalanced parentheses, missing \")\"")
+	}
+
+	return nil
+}
+
+func isPCLComparisonOperator(op string) bool {
+	for _, o := range pclComparisonOperators {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
+
+// shouldValidateConditions reports whether PCL conditions must be validated at
+// plan time, which can be disabled with the `validate_conditions` provider
+// argument.
+func shouldValidateConditions(meta interface{}) bool {
+	if c, ok := meta.(*Config); ok {
+		return c.ValidateConditions
+	}
+	return true
+}
+
+func pclConditionError(subject, loc, expr string, err error) error {
+	return fmt.Errorf("Invalid condition %s (%s): %s in expression %q. Set `validate_conditions = false` in the provider configuration to skip this check", subject, loc, err, expr)
+}
+
+// checkConditionExpressions validates the expressions of the `condition`
+// blocks found at loc.
+func checkConditionExpressions(diff *schema.ResourceDiff, loc, subject string) error {
+	num := diff.Get(fmt.Sprintf("%s.#", loc)).(int)
+	for i := 0; i < num; i++ {
+		key := fmt.Sprintf("%s.%d.expression", loc, i)
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+		expr := diff.Get(key).(string)
+		if err := validatePCLExpression(expr); err != nil {
+			return pclConditionError(subject, key, expr, err)
+		}
+	}
+	return nil
+}
+
+func checkEventOrchestrationPathConditions(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !shouldValidateConditions(meta) {
+		return nil
+	}
+
+	sn := diff.Get("set.#").(int)
+	for si := 0; si < sn; si++ {
+		rn := diff.Get(fmt.Sprintf("set.%d.rule.#", si)).(int)
+		for ri := 0; ri < rn; ri++ {
+			prefix := fmt.Sprintf("set.%d.rule.%d", si, ri)
+			subject := "in rule"
+			if label := diff.Get(prefix + ".label").(string); label != "" {
+				subject = fmt.Sprintf("in rule %q", label)
+			}
+			if err := checkConditionExpressions(diff, prefix+".condition", subject); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkEventOrchestrationCacheVariableConditions(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !shouldValidateConditions(meta) {
+		return nil
+	}
+
+	return checkConditionExpressions(diff, "condition", fmt.Sprintf("in cache variable %q", diff.Get("name").(string)))
+}
+
+func checkIncidentWorkflowTriggerCondition(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !shouldValidateConditions(meta) || !diff.NewValueKnown("condition") {
+		return nil
+	}
+	if diff.Get("type").(string) != "conditional" {
+		return nil
+	}
+
+	expr, ok := diff.GetOk("condition")
+	if !ok {
+		return nil
+	}
+	if err := validatePCLExpression(expr.(string)); err != nil {
+		subject := "of incident workflow trigger"
+		if workflow := diff.Get("workflow").(string); workflow != "" && diff.NewValueKnown("workflow") {
+			subject = fmt.Sprintf("of incident workflow trigger for workflow %q", workflow)
+		}
+		return pclConditionError(subject, "condition", expr.(string), err)
+	}
+	return nil
+}
+
+// customizeDiffAll runs each of funcs in order, stopping at the first error.
+func customizeDiffAll(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		for _, f := range funcs {
+			if err := f(ctx, diff, meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,48 @@
+package pagerduty
+
+import (
+	"testing"
+)
+
+func TestValidatePCLExpression(t *testing.T) {
+	valid := []string{
+		"event.summary matches 'timeout'",
+		"event.severity == 'critical' and event.source != \"db-01\"",
+		"(event.summary matches part 'disk' or event.summary matches regex '(cpu|mem) 100%') and not event.custom_details.ignore exists",
+		"event.custom_details.count >= 5",
+		"incident.priority matches 'P1' and incident.urgency matches 'high'",
+		"trigger_count > 1",
+		"now in Mon,Tue,Wed,Thu,Fri 09:00:00 to 17:00:00 America/Los_Angeles",
+		"event.summary matches 'it''s (broken'",
+		"event.summary matches 'escaped \\' quote'",
+	}
+	for _, expr := range valid {
+		if err := validatePCLExpression(expr); err != nil {
+			t.Errorf("expected %q to be valid, got: %s", expr, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"   ",
+		"(event.summary matches 'foo'",
+		"event.summary matches 'foo')",
+		"event.summary matches 'foo",
+		"event.severity = 'critical'",
+		"event.severity == 'critical' && event.source == 'db'",
+		"event.severity == 'critical' || event.source == 'db'",
+		"event.severity === 'critical'",
+		"event.severity == 'critical' and",
+		"or event.severity == 'critical'",
+		"event.severity == 'critical' and or event.source == 'db'",
+		"event.severity ==",
+		"== 'critical'",
+		"event.severity == 'critical' and ()",
+		"not",
+	}
+	for _, expr := range invalid {
+		if err := validatePCLExpression(expr); err == nil {
+			t.Errorf("expected %q to be invalid", expr)
+		}
+	}
+}
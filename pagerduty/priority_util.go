@@ -0,0 +1,234 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// listPriorities returns the priorities configured for the account. The list
+// is only requested once per provider run and then served from memory.
+func (c *Config) listPriorities() ([]*pagerduty.Priority, error) {
+	c = c.root()
+	c.prioritiesMu.Lock()
+	defer c.prioritiesMu.Unlock()
+
+	if c.priorities != nil {
+		return c.priorities, nil
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	var priorities []*pagerduty.Priority
+	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+		resp, _, err := client.Priorities.List()
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusForbidden) {
+				return retry.NonRetryableError(err)
+			}
+
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+		priorities = resp.Priorities
+		return nil
+	})
+	if retryErr != nil {
+		return nil, retryErr
+	}
+
+	if priorities == nil {
+		priorities = []*pagerduty.Priority{}
+	}
+	c.priorities = priorities
+
+	return c.priorities, nil
+}
+
+// resolvePriorityID returns the ID of the priority referenced by ref, which can
+// be either a priority ID or a priority name. IDs are looked up first, so a
+// priority named like an ID can still be referenced by its name. Names are
+// matched case insensitively and must match exactly one priority of the
+// account.
+func (c *Config) resolvePriorityID(ref string) (string, error) {
+	if ref == "" {
+		return ref, nil
+	}
+
+	priorities, err := c.listPriorities()
+	if err != nil {
+		return "", fmt.Errorf("Error resolving priority %q: %w", ref, err)
+	}
+
+	for _, p := range priorities {
+		if p.ID == ref {
+			return p.ID, nil
+		}
+	}
+
+	var matches []*pagerduty.Priority
+	for _, p := range priorities {
+		if strings.EqualFold(p.Name, ref) {
+			matches = append(matches, p)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("Unable to locate any priority with the name: %s", ref)
+	case 1:
+		log.Printf("[DEBUG] Resolved priority %q to %s", ref, matches[0].ID)
+		return matches[0].ID, nil
+	}
+
+	return "", fmt.Errorf("Priority name %q matches %d priorities, please reference the priority by its ID instead", ref, len(matches))
+}
+
+// priorityRefMatchesID reports whether ref, given either as a priority ID or a
+// priority name, references the priority identified by id.
+func (c *Config) priorityRefMatchesID(ref, id string) bool {
+	if ref == id {
+		return true
+	}
+	if ref == "" || id == "" {
+		return false
+	}
+
+	resolved, err := c.resolvePriorityID(ref)
+	if err != nil {
+		log.Printf("[WARN] %s", err)
+		return false
+	}
+
+	return resolved == id
+}
+
+// resolveRuleActionsPriority replaces the priority name set on rule actions
+// with the ID of the priority it references.
+func resolveRuleActionsPriority(c *Config, actions *pagerduty.RuleActions) error {
+	if actions == nil || actions.Priority == nil {
+		return nil
+	}
+
+	id, err := c.resolvePriorityID(actions.Priority.Value)
+	if err != nil {
+		return err
+	}
+	actions.Priority.Value = id
+
+	return nil
+}
+
+// preserveRuleActionsPriorityRef keeps the priority reference of the
+// configuration when it resolves to the priority returned by the API, so
+// referencing a priority by name doesn't produce a diff.
+func preserveRuleActionsPriorityRef(c *Config, d *schema.ResourceData, actions *pagerduty.RuleActions) {
+	if actions == nil || actions.Priority == nil {
+		return
+	}
+
+	ref, _ := d.Get("actions.0.priority.0.value").(string)
+	if c.priorityRefMatchesID(ref, actions.Priority.Value) {
+		actions.Priority.Value = ref
+	}
+}
+
+// resolveEventOrchestrationPathPriorities replaces the priority names set on
+// the actions of an Event Orchestration Path with the IDs of the priorities
+// they reference.
+func resolveEventOrchestrationPathPriorities(c *Config, p *pagerduty.EventOrchestrationPath) error {
+	resolve := func(actions *pagerduty.EventOrchestrationPathRuleActions) error {
+		if actions == nil {
+			return nil
+		}
+		id, err := c.resolvePriorityID(actions.Priority)
+		if err != nil {
+			return err
+		}
+		actions.Priority = id
+		return nil
+	}
+
+	for _, s := range p.Sets {
+		for _, r := range s.Rules {
+			if err := resolve(r.Actions); err != nil {
+				return err
+			}
+		}
+	}
+	if p.CatchAll != nil {
+		return resolve(p.CatchAll.Actions)
+	}
+
+	return nil
+}
+
+// checkEventOrchestrationPathPriorities checks at plan time that the priority
+// names set on the actions of an Event Orchestration Path resolve to exactly
+// one priority of the account. Only the changed priorities are checked.
+func checkEventOrchestrationPathPriorities(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	c, ok := meta.(*Config)
+	if !ok {
+		return nil
+	}
+
+	keys := []string{"catch_all.0.actions.0.priority"}
+	sn := diff.Get("set.#").(int)
+	for si := 0; si < sn; si++ {
+		rn := diff.Get(fmt.Sprintf("set.%d.rule.#", si)).(int)
+		for ri := 0; ri < rn; ri++ {
+			keys = append(keys, fmt.Sprintf("set.%d.rule.%d.actions.0.priority", si, ri))
+		}
+	}
+
+	for _, key := range keys {
+		if !diff.HasChange(key) || !diff.NewValueKnown(key) {
+			continue
+		}
+		ref, _ := diff.Get(key).(string)
+		if _, err := c.resolvePriorityID(ref); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// preserveEventOrchestrationPathPriorityRefs keeps the priority references of
+// the configuration on the actions of an Event Orchestration Path returned by
+// the API when they resolve to the same priority, so referencing a priority by
+// name doesn't produce a diff.
+func preserveEventOrchestrationPathPriorityRefs(c *Config, d *schema.ResourceData, p *pagerduty.EventOrchestrationPath) {
+	if p == nil {
+		return
+	}
+
+	preserve := func(key string, actions *pagerduty.EventOrchestrationPathRuleActions) {
+		if actions == nil {
+			return
+		}
+		ref, _ := d.Get(key).(string)
+		if c.priorityRefMatchesID(ref, actions.Priority) {
+			actions.Priority = ref
+		}
+	}
+
+	for si, s := range p.Sets {
+		for ri, r := range s.Rules {
+			preserve(fmt.Sprintf("set.%d.rule.%d.actions.0.priority", si, ri), r.Actions)
+		}
+	}
+	if p.CatchAll != nil {
+		preserve("catch_all.0.actions.0.priority", p.CatchAll.Actions)
+	}
+}
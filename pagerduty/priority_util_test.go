@@ -0,0 +1,57 @@
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestResourcePagerDutyResolvePriorityID(t *testing.T) {
+	c := &Config{
+		priorities: []*pagerduty.Priority{
+			{ID: "PAB12CD", Name: "P1"},
+			{ID: "PAB12CE", Name: "P2"},
+			{ID: "PAB12CF", Name: "Dup"},
+			{ID: "PAB12CG", Name: "dup"},
+			{ID: "PAB12CH", Name: "PSEV001"},
+		},
+	}
+
+	cases := []struct {
+		ref      string
+		expected string
+		fails    bool
+	}{
+		{ref: "", expected: ""},
+		{ref: "PAB12CE", expected: "PAB12CE"},
+		{ref: "P1", expected: "PAB12CD"},
+		{ref: "p2", expected: "PAB12CE"},
+		{ref: "P5", fails: true},
+		{ref: "DUP", fails: true},
+		{ref: "PSEV001", expected: "PAB12CH"},
+		{ref: "PZZ99ZZ", fails: true},
+	}
+
+	for _, tc := range cases {
+		id, err := c.resolvePriorityID(tc.ref)
+		if tc.fails {
+			if err == nil {
+				t.Errorf("%q: expected error while resolving priority: got %s", tc.ref, id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error while resolving priority: %s", tc.ref, err)
+		}
+		if id != tc.expected {
+			t.Errorf("%q: expected priority ID to be %q: got %q", tc.ref, tc.expected, id)
+		}
+	}
+
+	if !c.priorityRefMatchesID("P1", "PAB12CD") {
+		t.Errorf("expected priority name P1 to match PAB12CD")
+	}
+	if c.priorityRefMatchesID("P2", "PAB12CD") {
+		t.Errorf("expected priority name P2 not to match PAB12CD")
+	}
+}
@@ -6,12 +6,18 @@ import (
 	"log"
 	"regexp"
 	"runtime"
+	"sort"
 	"strings"
+	"time"
+	"unicode"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 	"github.com/heimweh/go-pagerduty/persistentconfig"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 const (
@@ -83,11 +89,54 @@ func Provider(isMux bool) *schema.Provider {
 				Optional: true,
 				Default:  false,
 			},
+
+			"validate_conditions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"strict_missing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"dial_timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"keepalive_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"default_team": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"user_agent_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"endpoint_overrides": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"pagerduty_escalation_policy":                          dataSourcePagerDutyEscalationPolicy(),
+			"pagerduty_escalation_policies":                        dataSourcePagerDutyEscalationPolicies(),
 			"pagerduty_schedule":                                   dataSourcePagerDutySchedule(),
+			"pagerduty_schedule_gaps":                              dataSourcePagerDutyScheduleGaps(),
+			"pagerduty_schedule_overrides":                         dataSourcePagerDutyScheduleOverrides(),
 			"pagerduty_user":                                       dataSourcePagerDutyUser(),
 			"pagerduty_users":                                      dataSourcePagerDutyUsers(),
 			"pagerduty_licenses":                                   dataSourcePagerDutyLicenses(),
@@ -105,10 +154,15 @@ func Provider(isMux bool) *schema.Provider {
 			"pagerduty_event_orchestration_global_cache_variable":  dataSourcePagerDutyEventOrchestrationGlobalCacheVariable(),
 			"pagerduty_event_orchestration_service_cache_variable": dataSourcePagerDutyEventOrchestrationServiceCacheVariable(),
 			"pagerduty_automation_actions_runner":                  dataSourcePagerDutyAutomationActionsRunner(),
+			"pagerduty_automation_actions_runners":                 dataSourcePagerDutyAutomationActionsRunners(),
 			"pagerduty_automation_actions_action":                  dataSourcePagerDutyAutomationActionsAction(),
 			"pagerduty_incident_workflow":                          dataSourcePagerDutyIncidentWorkflow(),
 			"pagerduty_incident_custom_field":                      dataSourcePagerDutyIncidentCustomField(),
 			"pagerduty_team_members":                               dataSourcePagerDutyTeamMembers(),
+			"pagerduty_resource_exists":                            dataSourcePagerDutyResourceExists(),
+			"pagerduty_token_scopes":                               dataSourcePagerDutyTokenScopes(),
+			"pagerduty_client_stats":                               dataSourcePagerDutyClientStats(),
+			"pagerduty_incident":                                   dataSourcePagerDutyIncident(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -164,6 +218,15 @@ func Provider(isMux bool) *schema.Provider {
 		delete(p.ResourcesMap, "pagerduty_team")
 	}
 
+	for name, r := range p.ResourcesMap {
+		enforceStrictMissing(name, r)
+		reportAPIDeprecations(r)
+		routeEndpointOverride(name, r)
+	}
+	for _, r := range p.DataSourcesMap {
+		reportAPIDeprecations(r)
+	}
+
 	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
@@ -171,12 +234,17 @@ func Provider(isMux bool) *schema.Provider {
 			// We can therefore assume that if it's missing it's 0.10 or 0.11
 			terraformVersion = "0.11+compatible"
 		}
-		return providerConfigureContextFunc(ctx, d, terraformVersion)
+		meta, diags := providerConfigureContextFunc(ctx, d, terraformVersion)
+		return meta, append(diags, validateEndpointOverrideTypes(d, p.ResourcesMap)...)
 	}
 
 	return p
 }
 
+// pagerDutyAccessDeniedErrorCode is the code of the errors of the API for the
+// requests the user of the token isn't allowed to make.
+const pagerDutyAccessDeniedErrorCode = 2010
+
 func isErrCode(err error, code int) bool {
 	if e, ok := err.(*pagerduty.Error); ok && e.ErrorResponse.Response.StatusCode == code {
 		return true
@@ -209,6 +277,9 @@ func genError(err error, d *schema.ResourceData) error {
 	return fmt.Errorf("Error reading: %s: %s", d.Id(), err)
 }
 
+// handleNotFoundError removes the resource from state when err is a 404. With
+// the `strict_missing` provider argument set, this removal is turned into an
+// error by enforceStrictMissing when it happens on refresh.
 func handleNotFoundError(err error, d *schema.ResourceData) error {
 	if isErrCode(err, 404) || isMalformedNotFoundError(err) {
 		log.Printf("[WARN] Removing %s because it's gone", d.Id())
@@ -218,8 +289,50 @@ func handleNotFoundError(err error, d *schema.ResourceData) error {
 	return genError(err, d)
 }
 
+// enforceStrictMissing wraps the Read of the resource r so a resource removed
+// from state because it's gone, usually by handleNotFoundError, is reported as
+// an error when the `strict_missing` provider argument is set. Reads done
+// while creating or updating aren't affected.
+func enforceStrictMissing(name string, r *schema.Resource) {
+	wrap := func(read schema.ReadContextFunc) schema.ReadContextFunc {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			id := d.Id()
+			diags := read(ctx, d, meta)
+			if diags.HasError() || id == "" || d.Id() != "" {
+				return diags
+			}
+			if c, ok := meta.(*Config); !ok || !c.StrictMissing {
+				return diags
+			}
+
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  util.StrictMissingSummary(name, id),
+				Detail:   util.StrictMissingDetail(name, id),
+			})
+		}
+	}
+
+	switch {
+	case r.ReadContext != nil:
+		r.ReadContext = wrap(r.ReadContext)
+	case r.ReadWithoutTimeout != nil:
+		r.ReadWithoutTimeout = wrap(r.ReadWithoutTimeout)
+	case r.Read != nil:
+		read := r.Read
+		r.Read = nil
+		r.ReadContext = wrap(func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(read(d, meta))
+		})
+	}
+}
+
 func providerConfigureContextFunc(_ context.Context, data *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
-	var diags diag.Diagnostics
+	diags := validateProviderConfig(data)
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	serviceRegion := strings.ToLower(data.Get("service_region").(string))
 
 	var regionApiUrl string
@@ -231,7 +344,6 @@ func providerConfigureContextFunc(_ context.Context, data *schema.ResourceData,
 
 	config := Config{
 		ApiUrl:              "https://api." + regionApiUrl + "pagerduty.com",
-		AppUrl:              "https://app." + regionApiUrl + "pagerduty.com",
 		SkipCredsValidation: data.Get("skip_credentials_validation").(bool),
 		Token:               data.Get("token").(string),
 		UserToken:           data.Get("user_token").(string),
@@ -239,6 +351,13 @@ func providerConfigureContextFunc(_ context.Context, data *schema.ResourceData,
 		ApiUrlOverride:      data.Get("api_url_override").(string),
 		ServiceRegion:       serviceRegion,
 		InsecureTls:         data.Get("insecure_tls").(bool),
+		ValidateConditions:  data.Get("validate_conditions").(bool),
+		StrictMissing:       data.Get("strict_missing").(bool),
+		DialTimeout:         time.Duration(data.Get("dial_timeout_seconds").(int)) * time.Second,
+		KeepAlive:           time.Duration(data.Get("keepalive_seconds").(int)) * time.Second,
+		DefaultTeam:         data.Get("default_team").(string),
+		UserAgentSuffix:     data.Get("user_agent_suffix").(string),
+		EndpointOverrides:   expandEndpointOverrides(data.Get("endpoint_overrides")),
 	}
 
 	useAuthTokenType := pagerduty.AuthTokenTypeAPIToken
@@ -246,14 +365,6 @@ func providerConfigureContextFunc(_ context.Context, data *schema.ResourceData,
 		config.AppOauthScopedTokenParams = expandAppOauthTokenParams(attr)
 		config.AppOauthScopedTokenParams.Region = serviceRegion
 		useAuthTokenType = pagerduty.AuthTokenTypeUseAppCredentials
-		if err := validateAuthMethodConfig(data); err != nil {
-			diag := diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  "`token` and `use_app_oauth_scoped_token` are both configured at the same time",
-				Detail:   err.Error(),
-			}
-			diags = append(diags, diag)
-		}
 	}
 
 	config.APITokenType = &useAuthTokenType
@@ -262,6 +373,99 @@ func providerConfigureContextFunc(_ context.Context, data *schema.ResourceData,
 	return &config, diags
 }
 
+// validateProviderConfig checks the arguments of the provider all at once, so
+// every conflicting or missing argument is reported together instead of
+// failing on them one at a time.
+func validateProviderConfig(data *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, isSetAPIToken := data.GetOk("token")
+	oauth, isSetUseAppOauthScopedToken := data.GetOk("use_app_oauth_scoped_token")
+
+	if err := validateAuthMethodConfig(data); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "`token` and `use_app_oauth_scoped_token` are both configured at the same time",
+			Detail:   err.Error(),
+		})
+	}
+
+	if isSetUseAppOauthScopedToken {
+		var missing []string
+		var params map[string]interface{}
+		if l := oauth.([]interface{}); len(l) > 0 && !isNilFunc(l[0]) {
+			params = l[0].(map[string]interface{})
+		}
+		for _, k := range []string{"pd_client_id", "pd_client_secret", "pd_subdomain"} {
+			if v, _ := params[k].(string); v == "" {
+				missing = append(missing, k)
+			}
+		}
+		if len(missing) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf(`Missing required arguments of use_app_oauth_scoped_token: "%s"`, strings.Join(missing, `", "`)),
+				Detail:        "The App Oauth scoped token can't be obtained without them, they can also be sourced from the PAGERDUTY_CLIENT_ID, PAGERDUTY_CLIENT_SECRET and PAGERDUTY_SUBDOMAIN environment variables.",
+				AttributePath: cty.GetAttrPath("use_app_oauth_scoped_token"),
+			})
+		}
+	} else if !isSetAPIToken {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Missing `token` or `use_app_oauth_scoped_token`",
+			Detail:        strings.TrimSpace(invalidCreds),
+			AttributePath: cty.GetAttrPath("token"),
+		})
+	}
+
+	serviceRegion := strings.ToLower(data.Get("service_region").(string))
+	apiURLOverride := data.Get("api_url_override").(string)
+	switch {
+	case serviceRegion != "" && serviceRegion != "us" && serviceRegion != "eu" && apiURLOverride == "":
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("Unknown `service_region` %q", serviceRegion),
+			Detail:        fmt.Sprintf("The known service regions are \"us\" and \"eu\", the PagerDuty API is called through api.%s.pagerduty.com. Use `api_url_override` to reach the PagerDuty API through another host.", serviceRegion),
+			AttributePath: cty.GetAttrPath("service_region"),
+		})
+	case serviceRegion != "" && serviceRegion != "us" && apiURLOverride != "":
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "`api_url_override` and `service_region` are both configured at the same time",
+			Detail:        fmt.Sprintf("The PagerDuty API is called through %s instead of the API host of the %q service region, which is still used by `use_app_oauth_scoped_token` and the web app calls.", apiURLOverride, serviceRegion),
+			AttributePath: cty.GetAttrPath("api_url_override"),
+		})
+	}
+
+	if suffix := data.Get("user_agent_suffix").(string); strings.IndexFunc(suffix, unicode.IsControl) >= 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "`user_agent_suffix` must not contain control characters",
+			Detail:        fmt.Sprintf("The suffix %q is sent in the User-Agent header of the requests to PagerDuty, which can't carry control characters like new lines or tabs.", suffix),
+			AttributePath: cty.GetAttrPath("user_agent_suffix"),
+		})
+	}
+
+	overrides := expandEndpointOverrides(data.Get("endpoint_overrides"))
+	resourceTypes := make([]string, 0, len(overrides))
+	for resourceType := range overrides {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+	for _, resourceType := range resourceTypes {
+		if err := validateEndpointOverrideURL(overrides[resourceType]); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid `endpoint_overrides` URL of %s", resourceType),
+				Detail:        err.Error(),
+				AttributePath: cty.GetAttrPath("endpoint_overrides").IndexString(resourceType),
+			})
+		}
+	}
+
+	return diags
+}
+
 func expandAppOauthTokenParams(v interface{}) *persistentconfig.AppOauthScopedTokenParams {
 	aotp := &persistentconfig.AppOauthScopedTokenParams{}
 
@@ -1,14 +1,20 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -41,6 +47,223 @@ func TestProviderImpl(t *testing.T) {
 	var _ *schema.Provider = Provider(IsNotMuxed)
 }
 
+func TestProviderStrictMissing(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Read: func(d *schema.ResourceData, _ interface{}) error {
+			return handleNotFoundError(fmt.Errorf("GET API call to https://api.pagerduty.com/users/%s failed: 404 Not Found", d.Id()), d)
+		},
+	}
+	enforceStrictMissing("pagerduty_test", r)
+
+	for _, strict := range []bool{false, true} {
+		d := r.TestResourceData()
+		d.SetId("PABC123")
+
+		diags := r.ReadContext(context.Background(), d, &Config{StrictMissing: strict})
+		if diags.HasError() != strict {
+			t.Errorf("strict_missing = %v: expected error to be %v: got %v", strict, strict, diags)
+		}
+	}
+}
+
+func TestProviderIntegrationKeysSensitive(t *testing.T) {
+	keys := map[string]bool{"integration_key": true, "routing_key": true, "routing_keys": true}
+
+	var check func(path string, s map[string]*schema.Schema)
+	check = func(path string, s map[string]*schema.Schema) {
+		for k, v := range s {
+			if keys[k] && !v.Sensitive {
+				t.Errorf("%s.%s must be sensitive", path, k)
+			}
+			if r, ok := v.Elem.(*schema.Resource); ok {
+				check(path+"."+k, r.Schema)
+			}
+		}
+	}
+
+	p := Provider(IsNotMuxed)
+	for name, r := range p.ResourcesMap {
+		check(name, r.Schema)
+	}
+	for name, r := range p.DataSourcesMap {
+		check("data."+name, r.Schema)
+	}
+}
+
+func TestProviderConfigureValidation(t *testing.T) {
+	for _, k := range []string{"PAGERDUTY_TOKEN", "PAGERDUTY_USER_TOKEN", "PAGERDUTY_SERVICE_REGION", "PAGERDUTY_CLIENT_ID", "PAGERDUTY_CLIENT_SECRET", "PAGERDUTY_SUBDOMAIN"} {
+		t.Setenv(k, "")
+		os.Unsetenv(k)
+	}
+
+	oauth := func(params map[string]interface{}) []interface{} {
+		return []interface{}{params}
+	}
+	fullOauth := map[string]interface{}{
+		"pd_client_id":     "client",
+		"pd_client_secret": "secret",
+		"pd_subdomain":     "acme",
+	}
+
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		errors   []string
+		warnings []string
+	}{
+		{
+			name: "token",
+			raw:  map[string]interface{}{"token": "foo"},
+		},
+		{
+			name: "oauth",
+			raw:  map[string]interface{}{"use_app_oauth_scoped_token": oauth(fullOauth)},
+		},
+		{
+			name:   "no credentials",
+			raw:    map[string]interface{}{},
+			errors: []string{"Missing `token` or `use_app_oauth_scoped_token`"},
+		},
+		{
+			name:     "token and oauth",
+			raw:      map[string]interface{}{"token": "foo", "use_app_oauth_scoped_token": oauth(fullOauth)},
+			warnings: []string{"`token` and `use_app_oauth_scoped_token` are both configured at the same time"},
+		},
+		{
+			name:   "oauth missing arguments",
+			raw:    map[string]interface{}{"use_app_oauth_scoped_token": oauth(map[string]interface{}{"pd_subdomain": "acme"})},
+			errors: []string{`Missing required arguments of use_app_oauth_scoped_token: "pd_client_id", "pd_client_secret"`},
+		},
+		{
+			name:     "unknown region",
+			raw:      map[string]interface{}{"token": "foo", "service_region": "moon"},
+			warnings: []string{`Unknown ` + "`service_region`" + ` "moon"`},
+		},
+		{
+			name: "eu region",
+			raw:  map[string]interface{}{"token": "foo", "service_region": "EU"},
+		},
+		{
+			name:     "region and api url override",
+			raw:      map[string]interface{}{"token": "foo", "service_region": "eu", "api_url_override": "https://proxy.example.com"},
+			warnings: []string{"`api_url_override` and `service_region` are both configured at the same time"},
+		},
+		{
+			name: "us region and api url override",
+			raw:  map[string]interface{}{"token": "foo", "service_region": "us", "api_url_override": "https://proxy.example.com"},
+		},
+		{
+			name: "user agent suffix",
+			raw:  map[string]interface{}{"token": "foo", "user_agent_suffix": "acme-gateway/1.0"},
+		},
+		{
+			name:   "user agent suffix with control characters",
+			raw:    map[string]interface{}{"token": "foo", "user_agent_suffix": "acme\r\nX-Injected: true"},
+			errors: []string{"`user_agent_suffix` must not contain control characters"},
+		},
+		{
+			name: "endpoint overrides",
+			raw:  map[string]interface{}{"token": "foo", "endpoint_overrides": map[string]interface{}{"pagerduty_event_orchestration": "http://localhost:8080/v2/"}},
+		},
+		{
+			name:   "invalid endpoint overrides",
+			raw:    map[string]interface{}{"token": "foo", "endpoint_overrides": map[string]interface{}{"pagerduty_ruleset": "localhost:8080", "pagerduty_event_orchestration": "https://mock.example.com?debug=1"}},
+			errors: []string{"Invalid `endpoint_overrides` URL of pagerduty_event_orchestration", "Invalid `endpoint_overrides` URL of pagerduty_ruleset"},
+		},
+		{
+			name:     "every conflict at once",
+			raw:      map[string]interface{}{"token": "foo", "service_region": "moon", "use_app_oauth_scoped_token": oauth(map[string]interface{}{"pd_client_id": "client"})},
+			errors:   []string{`Missing required arguments of use_app_oauth_scoped_token: "pd_client_secret", "pd_subdomain"`},
+			warnings: []string{"`token` and `use_app_oauth_scoped_token` are both configured at the same time", `Unknown ` + "`service_region`" + ` "moon"`},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Provider(IsNotMuxed).Schema, c.raw)
+			meta, diags := providerConfigureContextFunc(context.Background(), d, "1.5.0")
+
+			var errors, warnings []string
+			for _, v := range diags {
+				if v.Severity == diag.Error {
+					errors = append(errors, v.Summary)
+				} else {
+					warnings = append(warnings, v.Summary)
+				}
+			}
+			if fmt.Sprint(errors) != fmt.Sprint(c.errors) {
+				t.Errorf("expected the errors %q, got %q", c.errors, errors)
+			}
+			if fmt.Sprint(warnings) != fmt.Sprint(c.warnings) {
+				t.Errorf("expected the warnings %q, got %q", c.warnings, warnings)
+			}
+			if (meta == nil) != (len(c.errors) > 0) {
+				t.Errorf("expected the provider to be configured only without errors, got %v", meta)
+			}
+		})
+	}
+}
+
+func TestProviderEndpointOverrides(t *testing.T) {
+	handler := func(requests *[]string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			*requests = append(*requests, r.URL.Path)
+			switch {
+			case strings.HasPrefix(r.URL.Path, "/event_orchestrations/"):
+				w.Write([]byte(`{"orchestration":{"id":"E5EB8D1A","name":"mock"}}`))
+			case strings.HasPrefix(r.URL.Path, "/rulesets/"):
+				w.Write([]byte(`{"ruleset":{"id":"PRSET12","name":"foo","type":"global"}}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+			}
+		}
+	}
+	var apiRequests, mockRequests []string
+	api := httptest.NewServer(handler(&apiRequests))
+	defer api.Close()
+	mock := httptest.NewServer(handler(&mockRequests))
+	defer mock.Close()
+
+	p := Provider(IsNotMuxed)
+	diags := p.Configure(context.Background(), sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+		"token":                       "foo",
+		"skip_credentials_validation": true,
+		"api_url_override":            api.URL,
+		"endpoint_overrides": map[string]interface{}{
+			"pagerduty_event_orchestration": mock.URL + "/",
+			"pagerduty_nope":                mock.URL,
+		},
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Summary != "`endpoint_overrides` of pagerduty_nope is ignored" {
+		t.Errorf("expected a warning about the unknown resource type, got %v", diags)
+	}
+
+	for name, id := range map[string]string{"pagerduty_event_orchestration": "E5EB8D1A", "pagerduty_ruleset": "PRSET12"} {
+		r := p.ResourcesMap[name]
+		d := r.TestResourceData()
+		d.SetId(id)
+		if diags := r.ReadContext(context.Background(), d, p.Meta()); diags.HasError() {
+			t.Fatalf("unexpected error reading %s: %v", name, diags)
+		}
+	}
+
+	if fmt.Sprint(mockRequests) != "[/event_orchestrations/E5EB8D1A]" {
+		t.Errorf("expected the event orchestration to be read from the mock server, got %v", mockRequests)
+	}
+	if fmt.Sprint(apiRequests) != "[/rulesets/PRSET12]" {
+		t.Errorf("expected the ruleset to be read from the API, got %v", apiRequests)
+	}
+	if requests := p.Meta().(*Config).stats.totalRequests(); requests != 2 {
+		t.Errorf("expected the requests to both servers to be counted in the stats of the provider, got %d", requests)
+	}
+}
+
 func TestAccPagerDutyProviderAuthMethods_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
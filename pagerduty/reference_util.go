@@ -0,0 +1,41 @@
+package pagerduty
+
+import (
+	"log"
+)
+
+// referenceKey identifies an object looked up by name, kind tells apart the
+// lookups the object was found with as they don't all match names the same way.
+type referenceKey struct {
+	kind string
+	name string
+}
+
+// cachedReference returns the object a previous lookup of the provider run
+// found for a name, so configurations referencing the same users, schedules or
+// teams from many resources only search each of them once.
+func (c *Config) cachedReference(kind, name string) (interface{}, bool) {
+	c = c.root()
+	c.referencesMu.Lock()
+	defer c.referencesMu.Unlock()
+
+	v, ok := c.references[referenceKey{kind: kind, name: name}]
+	if ok {
+		log.Printf("[DEBUG] Using the cached %s named %q", kind, name)
+	}
+	return v, ok
+}
+
+// cacheReference keeps the object found for a name for the rest of the
+// provider run. Only successful lookups are cached, so objects created later in
+// the run can still be found.
+func (c *Config) cacheReference(kind, name string, v interface{}) {
+	c = c.root()
+	c.referencesMu.Lock()
+	defer c.referencesMu.Unlock()
+
+	if c.references == nil {
+		c.references = make(map[referenceKey]interface{})
+	}
+	c.references[referenceKey{kind: kind, name: name}] = v
+}
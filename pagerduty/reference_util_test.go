@@ -0,0 +1,77 @@
+package pagerduty
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestConfigCachedReferences(t *testing.T) {
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		calls[r.URL.Path]++
+		switch r.URL.Path {
+		case "/schedules":
+			w.Write([]byte(`{"schedules":[{"id":"PSCHED1","name":"primary"}]}`))
+		case "/users":
+			w.Write([]byte(`{"users":[{"id":"PUSER01","name":"Earline Greenholt","email":"earline@foo.test"}]}`))
+		case "/teams":
+			w.Write([]byte(`{"teams":[{"id":"PTEAM01","name":"Engineering","description":"All engineering"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	rules := []interface{}{
+		map[string]interface{}{
+			"escalation_delay_in_minutes": 10,
+			"target": []interface{}{
+				map[string]interface{}{"type": "schedule_reference", "name": "primary"},
+				map[string]interface{}{"type": "user_reference", "name": "Earline Greenholt"},
+			},
+		},
+	}
+	for i := 0; i < 2; i++ {
+		d := resourcePagerDutyEscalationPolicy().TestResourceData()
+		d.Set("rule", rules)
+		escalationRules := expandEscalationRules(d.Get("rule").([]interface{}))
+		if err := resolveEscalationRuleTargetNames(config, d, escalationRules); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := escalationRules[0].Targets[0].ID; got != "PSCHED1" {
+			t.Errorf("expected the schedule named primary to be resolved to PSCHED1, got %q", got)
+		}
+		if got := escalationRules[0].Targets[1].ID; got != "PUSER01" {
+			t.Errorf("expected the user named Earline Greenholt to be resolved to PUSER01, got %q", got)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		r := dataSourcePagerDutyTeam()
+		d := r.TestResourceData()
+		d.Set("name", "Engineering")
+		if err := r.Read(d, config); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if d.Id() != "PTEAM01" || d.Get("description") != "All engineering" {
+			t.Errorf("expected the team named Engineering to be found, got %s: %v", d.Id(), d.Get("description"))
+		}
+	}
+
+	for path, n := range calls {
+		if n != 1 {
+			t.Errorf("expected %s to be requested once, got %d requests", path, n)
+		}
+	}
+	if len(calls) != 3 {
+		t.Errorf("expected the schedules, users and teams to be looked up, got %v", calls)
+	}
+}
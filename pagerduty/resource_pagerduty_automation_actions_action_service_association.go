@@ -92,8 +92,8 @@ func fetchPagerDutyAutomationActionsActionServiceAssociation(d *schema.ResourceD
 			return nil
 		}
 
-		if resp.Service.ID != serviceID {
-			log.Printf("[WARN] Removing %s since the service: %s is not associated to the action: %s", d.Id(), actionID, serviceID)
+		if resp.Service == nil || resp.Service.ID != serviceID {
+			log.Printf("[WARN] Removing %s since the service: %s is not associated to the action: %s", d.Id(), serviceID, actionID)
 			d.SetId("")
 			return nil
 		}
@@ -1,11 +1,18 @@
 package pagerduty
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,8 +26,9 @@ func resourcePagerDutyEscalationPolicy() *schema.Resource {
 		Update: resourcePagerDutyEscalationPolicyUpdate,
 		Delete: resourcePagerDutyEscalationPolicyDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourcePagerDutyEscalationPolicyImport,
 		},
+		CustomizeDiff: customizeDiffAll(validateEscalationPolicyRules, checkEscalationRuleTargetNames),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:             schema.TypeString,
@@ -32,11 +40,34 @@ func resourcePagerDutyEscalationPolicy() *schema.Resource {
 				Optional: true,
 				Default:  "Managed by Terraform",
 			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"self": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"num_loops": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.IntBetween(0, 9),
 			},
+			"on_call_handoff_notifications": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateDiagFunc: validateValueDiagFunc([]string{
+					"if_has_services",
+					"always",
+				}),
+			},
+			"repeat_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the escalation policy repeats its rules after the last one, false when num_loops is 0",
+			},
 			"teams": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -45,6 +76,7 @@ func resourcePagerDutyEscalationPolicy() *schema.Resource {
 				},
 				MaxItems: 1,
 			},
+			"tags": entityTagsSchema,
 			"rule": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -84,17 +116,20 @@ func resourcePagerDutyEscalationPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"type": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Default:  "user_reference",
-										ValidateDiagFunc: validateValueDiagFunc([]string{
-											"user_reference",
-											"schedule_reference",
-										}),
+										Type:             schema.TypeString,
+										Optional:         true,
+										Default:          "user_reference",
+										ValidateDiagFunc: validateEscalationRuleTargetType,
 									},
 									"id": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
+										Computed: true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The name of the user or schedule to target, resolved to its ID instead of setting id",
 									},
 								},
 							},
@@ -106,6 +141,271 @@ func resourcePagerDutyEscalationPolicy() *schema.Resource {
 	}
 }
 
+// validateEscalationPolicyRules requires at least one rule with at least one
+// target, which can't be enforced by the schema when the blocks are generated
+// with dynamic blocks, and would otherwise be rejected by the API with a vague
+// error.
+func validateEscalationPolicyRules(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	rules := config.GetAttr("rule")
+	if !rules.IsKnown() {
+		return nil
+	}
+	if rules.IsNull() || rules.LengthInt() == 0 {
+		return fmt.Errorf("escalation policy must have at least one rule")
+	}
+
+	for i, rule := range rules.AsValueSlice() {
+		if rule.IsNull() || !rule.IsKnown() {
+			continue
+		}
+		targets := rule.GetAttr("target")
+		if !targets.IsKnown() {
+			continue
+		}
+		if targets.IsNull() || targets.LengthInt() == 0 {
+			return fmt.Errorf("rule.%d of escalation policy must have at least one target", i)
+		}
+
+		for j, target := range targets.AsValueSlice() {
+			if target.IsNull() || !target.IsKnown() {
+				continue
+			}
+			id, name := target.GetAttr("id"), target.GetAttr("name")
+			if !id.IsKnown() || !name.IsKnown() {
+				continue
+			}
+			if id.IsNull() == name.IsNull() {
+				return fmt.Errorf("rule.%d.target.%d of escalation policy must have exactly one of id or name", i, j)
+			}
+		}
+	}
+
+	return nil
+}
+
+// escalationRuleTargetTypes are the types of the targets of escalation rules.
+var escalationRuleTargetTypes = []string{"user_reference", "schedule_reference"}
+
+// validateEscalationRuleTargetType validates the type of a target like
+// validateValueDiagFunc, naming the rule and target it belongs to, e.g.
+// rule.0.target.1.type, so it's easy to spot in policies with many of them.
+func validateEscalationRuleTargetType(v interface{}, p cty.Path) diag.Diagnostics {
+	diags := validateValueDiagFunc(escalationRuleTargetTypes)(v, p)
+	for i := range diags {
+		diags[i].Summary = fmt.Sprintf("%s of escalation policy: %s", formatAttributePath(p), diags[i].Summary)
+	}
+
+	return diags
+}
+
+// formatAttributePath formats the path of an attribute with the dotted
+// notation of Terraform state, e.g. rule.0.target.1.type.
+func formatAttributePath(p cty.Path) string {
+	parts := make([]string, 0, len(p))
+	for _, step := range p {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			parts = append(parts, s.Name)
+		case cty.IndexStep:
+			if s.Key.Type() == cty.Number {
+				i, _ := s.Key.AsBigFloat().Int64()
+				parts = append(parts, strconv.FormatInt(i, 10))
+			} else if s.Key.Type() == cty.String {
+				parts = append(parts, s.Key.AsString())
+			}
+		}
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// resolveEscalationRuleTargetNames sets the IDs of the targets of the rules
+// configured with the name of a user or schedule instead of its ID.
+func resolveEscalationRuleTargetNames(c *Config, d *schema.ResourceData, escalationRules []*pagerduty.EscalationRule) error {
+	for i, er := range d.Get("rule").([]interface{}) {
+		rer, ok := er.(map[string]interface{})
+		if !ok || i >= len(escalationRules) {
+			continue
+		}
+
+		for j, ert := range rer["target"].([]interface{}) {
+			rert, ok := ert.(map[string]interface{})
+			if !ok || j >= len(escalationRules[i].Targets) {
+				continue
+			}
+			name := rert["name"].(string)
+			if name == "" {
+				continue
+			}
+
+			id, err := c.resolveEscalationTargetName(rert["type"].(string), name)
+			if err != nil {
+				return fmt.Errorf("rule.%d.target.%d of escalation policy: %w", i, j, err)
+			}
+			escalationRules[i].Targets[j].ID = id
+		}
+	}
+
+	return nil
+}
+
+// checkEscalationRuleTargetNames resolves the changed names of the targets of
+// the rules at plan time, so ambiguous names fail the plan and the IDs found
+// are the ones used when applying it. The users and schedules which aren't
+// found may be created by the same apply, they're looked up again then.
+func checkEscalationRuleTargetNames(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	c, ok := meta.(*Config)
+	if !ok {
+		return nil
+	}
+
+	rn := diff.Get("rule.#").(int)
+	for i := 0; i < rn; i++ {
+		tn := diff.Get(fmt.Sprintf("rule.%d.target.#", i)).(int)
+		for j := 0; j < tn; j++ {
+			key := fmt.Sprintf("rule.%d.target.%d", i, j)
+			if !diff.HasChange(key+".name") || !diff.NewValueKnown(key+".name") || !diff.NewValueKnown(key+".type") {
+				continue
+			}
+			name, _ := diff.Get(key + ".name").(string)
+			if name == "" {
+				continue
+			}
+
+			_, err := c.resolveEscalationTargetName(diff.Get(key+".type").(string), name)
+			var notFound *escalationTargetNotFoundError
+			if err != nil && !errors.As(err, &notFound) {
+				return fmt.Errorf("%s of escalation policy: %w", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resolveEscalationTargetName returns the ID of the only user or schedule with
+// the given name. The names already resolved during the provider run aren't
+// searched again.
+func (c *Config) resolveEscalationTargetName(targetType, name string) (string, error) {
+	if id, ok := c.cachedReference(targetType, name); ok {
+		return id.(string), nil
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		return "", err
+	}
+	id, err := findEscalationTargetIDByName(client, targetType, name)
+	if err != nil {
+		return "", err
+	}
+	c.cacheReference(targetType, name, id)
+
+	return id, nil
+}
+
+// escalationTargetNotFoundError is returned when no user or schedule has the
+// name of a target.
+type escalationTargetNotFoundError struct {
+	entity string
+	name   string
+}
+
+func (e *escalationTargetNotFoundError) Error() string {
+	return fmt.Sprintf("unable to locate any %s with the name: %s", e.entity, e.name)
+}
+
+// findEscalationTargetIDByName returns the ID of the only user or schedule
+// with the given name, going through every page of the matching ones.
+func findEscalationTargetIDByName(client *pagerduty.Client, targetType, name string) (string, error) {
+	var ids []string
+
+	switch targetType {
+	case "user_reference":
+		o := &pagerduty.ListUsersOptions{Query: name, Limit: 100}
+		for more := true; more; {
+			resp, _, err := client.Users.List(o)
+			if err != nil {
+				return "", err
+			}
+			for _, user := range resp.Users {
+				if user.Name == name {
+					ids = append(ids, user.ID)
+				}
+			}
+			more = resp.More && len(resp.Users) > 0
+			o.Offset += len(resp.Users)
+		}
+	case "schedule_reference":
+		o := &pagerduty.ListSchedulesOptions{Query: name, Limit: 100}
+		for more := true; more; {
+			resp, _, err := client.Schedules.List(o)
+			if err != nil {
+				return "", err
+			}
+			for _, schedule := range resp.Schedules {
+				if schedule.Name == name {
+					ids = append(ids, schedule.ID)
+				}
+			}
+			more = resp.More && len(resp.Schedules) > 0
+			o.Offset += len(resp.Schedules)
+		}
+	default:
+		return "", fmt.Errorf("targets of type %s can't be looked up by name", targetType)
+	}
+
+	entity := strings.TrimSuffix(targetType, "_reference")
+	switch len(ids) {
+	case 0:
+		return "", &escalationTargetNotFoundError{entity: entity, name: name}
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("%d %ss are named %s (%s), set the id of the target instead of its name", len(ids), entity, name, strings.Join(ids, ", "))
+	}
+}
+
+// keepEscalationRuleTargetNames keeps the names of the targets configured by
+// name in the flattened rules, as long as they're still the name of the
+// targeted user or schedule.
+func keepEscalationRuleTargetNames(d *schema.ResourceData, rules []map[string]interface{}, escalationRules []*pagerduty.EscalationRule) {
+	for i, er := range d.Get("rule").([]interface{}) {
+		rer, ok := er.(map[string]interface{})
+		if !ok || i >= len(rules) {
+			continue
+		}
+		targets, _ := rules[i]["target"].([]map[string]interface{})
+
+		for j, ert := range rer["target"].([]interface{}) {
+			rert, ok := ert.(map[string]interface{})
+			if !ok || j >= len(targets) {
+				continue
+			}
+			if name := rert["name"].(string); name != "" && name == escalationRules[i].Targets[j].Summary {
+				targets[j]["name"] = name
+			}
+		}
+	}
+}
+
+// isNumLoopsConfigured reports whether num_loops is set in the configuration,
+// including to 0.
+func isNumLoopsConfigured(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		_, ok := d.GetOk("num_loops")
+		return ok
+	}
+
+	return !rawConfig.GetAttr("num_loops").IsNull()
+}
+
 func buildEscalationPolicyStruct(d *schema.ResourceData) *pagerduty.EscalationPolicy {
 	escalationPolicy := &pagerduty.EscalationPolicy{
 		Name:            d.Get("name").(string),
@@ -116,8 +416,12 @@ func buildEscalationPolicyStruct(d *schema.ResourceData) *pagerduty.EscalationPo
 		escalationPolicy.Description = attr.(string)
 	}
 
-	loops := d.Get("num_loops").(int)
-	escalationPolicy.NumLoops = &loops
+	// num_loops is left to the default of the account when the policy is
+	// created without it, 0 can't be told apart from an unset value otherwise.
+	if d.Id() != "" || isNumLoopsConfigured(d) {
+		loops := d.Get("num_loops").(int)
+		escalationPolicy.NumLoops = &loops
+	}
 
 	if attr, ok := d.GetOk("teams"); ok {
 		escalationPolicy.Teams = expandTeams(attr.([]interface{}))
@@ -134,10 +438,14 @@ func resourcePagerDutyEscalationPolicyCreate(d *schema.ResourceData, meta interf
 	var readErr error
 
 	escalationPolicy := buildEscalationPolicyStruct(d)
+	escalationPolicy.Teams = applyDefaultTeam(escalationPolicy.Teams, meta)
+	if err := resolveEscalationRuleTargetNames(meta.(*Config), d, escalationPolicy.EscalationRules); err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Creating PagerDuty escalation policy: %s", escalationPolicy.Name)
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(5*time.Minute, func() *retry.RetryError {
 		escalationPolicy, _, err := client.EscalationPolicies.Create(escalationPolicy)
 		if err != nil {
 			if isErrCode(err, 429) {
@@ -147,7 +455,7 @@ func resourcePagerDutyEscalationPolicyCreate(d *schema.ResourceData, meta interf
 				return retry.RetryableError(err)
 			}
 
-			return retry.NonRetryableError(err)
+			return retry.NonRetryableError(explainEscalationPolicyAccessDenied(err, fmt.Sprintf("create escalation policy %q", d.Get("name").(string))))
 		}
 
 		d.SetId(escalationPolicy.ID)
@@ -157,11 +465,95 @@ func resourcePagerDutyEscalationPolicyCreate(d *schema.ResourceData, meta interf
 		}
 		return nil
 	})
+	if retryErr != nil {
+		return retryErr
+	}
+
+	if err := updateEscalationPolicyOnCallHandoffNotifications(client, d); err != nil {
+		return err
+	}
+
+	if err := updateEntityTags(client, d, "escalation_policies"); err != nil {
+		return err
+	}
+
+	return readEntityTags(client, d, "escalation_policies")
 }
 
 func resourcePagerDutyEscalationPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Reading PagerDuty escalation policy: %s", d.Id())
-	return fetchEscalationPolicy(d, meta, handleNotFoundError)
+	if err := fetchEscalationPolicy(d, meta, handleNotFoundError); err != nil || d.Id() == "" {
+		return err
+	}
+
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	return readEntityTags(client, d, "escalation_policies")
+}
+
+// escalationPolicyOnCallHandoffNotifications is the part of an escalation
+// policy with the field the escalation policy type of the API client doesn't
+// carry, which is sent with a request of its own.
+type escalationPolicyOnCallHandoffNotifications struct {
+	EscalationPolicy struct {
+		OnCallHandoffNotifications string `json:"on_call_handoff_notifications,omitempty"`
+	} `json:"escalation_policy"`
+}
+
+// updateEscalationPolicyOnCallHandoffNotifications sends the changed
+// on_call_handoff_notifications of the escalation policy, it's left to the
+// default of the API when it isn't configured.
+func updateEscalationPolicyOnCallHandoffNotifications(client *pagerduty.Client, d *schema.ResourceData) error {
+	v, ok := d.GetOk("on_call_handoff_notifications")
+	if !ok || !d.HasChange("on_call_handoff_notifications") {
+		return nil
+	}
+
+	log.Printf("[INFO] Updating on call handoff notifications of PagerDuty escalation policy: %s", d.Id())
+
+	var payload escalationPolicyOnCallHandoffNotifications
+	payload.EscalationPolicy.OnCallHandoffNotifications = v.(string)
+
+	var resp escalationPolicyOnCallHandoffNotifications
+	if err := putAPIResource(context.Background(), client, fmt.Sprintf("/escalation_policies/%s", d.Id()), payload, &resp); err != nil {
+		return fmt.Errorf("error updating on_call_handoff_notifications of escalation policy %s: %w", d.Id(), err)
+	}
+
+	return d.Set("on_call_handoff_notifications", resp.EscalationPolicy.OnCallHandoffNotifications)
+}
+
+// escalationPolicyWithOnCallHandoffNotifications is an escalation policy of
+// the API client along with its on_call_handoff_notifications, so both are
+// read from the same response.
+type escalationPolicyWithOnCallHandoffNotifications struct {
+	*pagerduty.EscalationPolicy
+	OnCallHandoffNotifications string `json:"on_call_handoff_notifications,omitempty"`
+}
+
+// getEscalationPolicy gets the escalation policy like the Get of the API
+// client, with the on_call_handoff_notifications the client leaves out.
+func getEscalationPolicy(client *pagerduty.Client, id string, o *pagerduty.GetEscalationPolicyOptions) (*escalationPolicyWithOnCallHandoffNotifications, error) {
+	query := url.Values{}
+	if o != nil {
+		for _, include := range o.Includes {
+			query.Add("include[]", include)
+		}
+	}
+
+	var v struct {
+		EscalationPolicy *escalationPolicyWithOnCallHandoffNotifications `json:"escalation_policy"`
+	}
+	if err := getAPIResource(context.Background(), client, fmt.Sprintf("/escalation_policies/%s", id), query, &v); err != nil {
+		return nil, err
+	}
+	if v.EscalationPolicy == nil || v.EscalationPolicy.EscalationPolicy == nil {
+		return nil, fmt.Errorf("escalation policy %s is missing from the API response", id)
+	}
+
+	return v.EscalationPolicy, nil
 }
 
 func fetchEscalationPolicy(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
@@ -172,9 +564,9 @@ func fetchEscalationPolicy(d *schema.ResourceData, meta interface{}, errCallback
 
 	o := &pagerduty.GetEscalationPolicyOptions{Includes: []string{"escalation_rule_assignment_strategies"}}
 
-	var escalationPolicyFirstAttempt *pagerduty.EscalationPolicy
+	var escalationPolicyFirstAttempt *escalationPolicyWithOnCallHandoffNotifications
 
-	escalationPolicyFirstAttempt, _, err = client.EscalationPolicies.Get(d.Id(), o)
+	escalationPolicyFirstAttempt, err = getEscalationPolicy(client, d.Id(), o)
 	if err != nil && isErrCode(err, http.StatusForbidden) || isMalformedForbiddenError(err) {
 		// Removing the inclusion of escalation_rule_assignment_strategies for
 		// accounts wihtout the required entitlements.
@@ -182,11 +574,11 @@ func fetchEscalationPolicy(d *schema.ResourceData, meta interface{}, errCallback
 	}
 
 	if err == nil && escalationPolicyFirstAttempt != nil {
-		return setResourceEPProps(d, escalationPolicyFirstAttempt)
+		return setResourceEPProps(d, escalationPolicyFirstAttempt, meta)
 	}
 
 	return retry.Retry(5*time.Minute, func() *retry.RetryError {
-		escalationPolicy, _, err := client.EscalationPolicies.Get(d.Id(), o)
+		escalationPolicy, err := getEscalationPolicy(client, d.Id(), o)
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusForbidden) || isMalformedForbiddenError(err) {
 				return retry.NonRetryableError(err)
@@ -202,7 +594,7 @@ func fetchEscalationPolicy(d *schema.ResourceData, meta interface{}, errCallback
 			return nil
 		}
 
-		err = setResourceEPProps(d, escalationPolicy)
+		err = setResourceEPProps(d, escalationPolicy, meta)
 		if err != nil {
 			return retry.NonRetryableError(err)
 		}
@@ -211,21 +603,51 @@ func fetchEscalationPolicy(d *schema.ResourceData, meta interface{}, errCallback
 	})
 }
 
-func setResourceEPProps(d *schema.ResourceData, escalationPolicy *pagerduty.EscalationPolicy) error {
+func setResourceEPProps(d *schema.ResourceData, escalationPolicy *escalationPolicyWithOnCallHandoffNotifications, meta interface{}) error {
 	d.Set("name", escalationPolicy.Name)
+	d.Set("on_call_handoff_notifications", escalationPolicy.OnCallHandoffNotifications)
 	d.Set("description", escalationPolicy.Description)
 	d.Set("num_loops", escalationPolicy.NumLoops)
+	// The policy repeats whenever it loops, also when the API response
+	// leaves repeat_enabled out.
+	d.Set("repeat_enabled", escalationPolicy.RepeatEnabled || (escalationPolicy.NumLoops != nil && *escalationPolicy.NumLoops > 0))
+	d.Set("html_url", escalationPolicy.HTMLURL)
+	d.Set("self", escalationPolicy.Self)
 
-	if err := d.Set("teams", flattenTeams(escalationPolicy.Teams)); err != nil {
+	if err := d.Set("teams", omitDefaultTeam(d, flattenTeams(escalationPolicy.Teams), meta)); err != nil {
 		return fmt.Errorf("error setting teams: %s", err)
 	}
 
-	if err := d.Set("rule", flattenEscalationRules(escalationPolicy.EscalationRules)); err != nil {
+	rules := flattenEscalationRules(escalationPolicy.EscalationRules)
+	keepEscalationRuleTargetNames(d, rules, escalationPolicy.EscalationRules)
+	if err := d.Set("rule", rules); err != nil {
 		return err
 	}
 	return nil
 }
 
+// resourcePagerDutyEscalationPolicyImport sets the teams of the imported
+// policy ahead of the Read following the import, so the default team of the
+// provider isn't left out of them when the policy is associated to it, as
+// there is no configuration yet telling it was assigned by the provider.
+func resourcePagerDutyEscalationPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	escalationPolicy, _, err := client.EscalationPolicies.Get(d.Id(), nil)
+	if err != nil {
+		return []*schema.ResourceData{}, fmt.Errorf("error importing pagerduty_escalation_policy %s: %w", d.Id(), err)
+	}
+
+	if err := d.Set("teams", flattenTeams(escalationPolicy.Teams)); err != nil {
+		return []*schema.ResourceData{}, fmt.Errorf("error setting teams: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourcePagerDutyEscalationPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -233,12 +655,22 @@ func resourcePagerDutyEscalationPolicyUpdate(d *schema.ResourceData, meta interf
 	}
 
 	escalationPolicy := buildEscalationPolicyStruct(d)
+	escalationPolicy.Teams = applyDefaultTeam(escalationPolicy.Teams, meta)
+	if err := resolveEscalationRuleTargetNames(meta.(*Config), d, escalationPolicy.EscalationRules); err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Updating PagerDuty escalation policy: %s", d.Id())
 
 	_, _, err = client.EscalationPolicies.Update(d.Id(), escalationPolicy)
 	if err == nil {
-		return nil
+		if err := removeEscalationPolicyTeams(client, d, escalationPolicy.Teams); err != nil {
+			return err
+		}
+		if err := updateEscalationPolicyOnCallHandoffNotifications(client, d); err != nil {
+			return err
+		}
+		return updateEntityTags(client, d, "escalation_policies")
 	}
 
 	if isErrCode(err, http.StatusForbidden) || isMalformedForbiddenError(err) {
@@ -270,7 +702,57 @@ func resourcePagerDutyEscalationPolicyUpdate(d *schema.ResourceData, meta interf
 	})
 	if retryErr != nil {
 		time.Sleep(2 * time.Second)
-		return retryErr
+		return explainEscalationPolicyAccessDenied(retryErr, fmt.Sprintf("update escalation policy %s", d.Id()))
+	}
+
+	if err := removeEscalationPolicyTeams(client, d, escalationPolicy.Teams); err != nil {
+		return err
+	}
+	if err := updateEscalationPolicyOnCallHandoffNotifications(client, d); err != nil {
+		return err
+	}
+	return updateEntityTags(client, d, "escalation_policies")
+}
+
+// removeEscalationPolicyTeams unassigns the teams removed from the teams
+// attribute which aren't in the teams sent in the update, the API leaves the
+// team associations of an escalation policy as they are when the update
+// request has no teams.
+func removeEscalationPolicyTeams(client *pagerduty.Client, d *schema.ResourceData, teams []*pagerduty.TeamReference) error {
+	if !d.HasChange("teams") {
+		return nil
+	}
+
+	o, _ := d.GetChange("teams")
+	keep := make(map[string]bool)
+	for _, t := range teams {
+		keep[t.ID] = true
+	}
+
+	for _, t := range o.([]interface{}) {
+		teamID := t.(string)
+		if keep[teamID] {
+			continue
+		}
+
+		log.Printf("[INFO] Removing PagerDuty escalation policy %s from team %s", d.Id(), teamID)
+
+		retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+			if _, err := client.Teams.RemoveEscalationPolicy(teamID, d.Id()); err != nil {
+				if isErrCode(err, http.StatusNotFound) {
+					return nil
+				}
+				if isErrCode(err, http.StatusBadRequest) {
+					return retry.NonRetryableError(err)
+				}
+
+				return retry.RetryableError(err)
+			}
+			return nil
+		})
+		if retryErr != nil {
+			return fmt.Errorf("error removing escalation policy %s from team %s: %s", d.Id(), teamID, retryErr)
+		}
 	}
 
 	return nil
@@ -290,6 +772,9 @@ func resourcePagerDutyEscalationPolicyDelete(d *schema.ResourceData, meta interf
 			if isErrCode(err, 400) {
 				return retry.RetryableError(err)
 			}
+			if isEscalationPolicyAccessDeniedError(err) {
+				return retry.NonRetryableError(explainEscalationPolicyAccessDenied(err, fmt.Sprintf("delete escalation policy %s", d.Id())))
+			}
 
 			err = handleNotFoundError(err, d)
 			if err != nil {
@@ -312,6 +797,30 @@ func resourcePagerDutyEscalationPolicyDelete(d *schema.ResourceData, meta interf
 	return nil
 }
 
+// isEscalationPolicyAccessDeniedError reports whether err is the 403 of the
+// API rejecting a change to an escalation policy the user of the token isn't
+// allowed to make, telling it apart from the other 403s of the API, like the
+// ones of the features missing from the pricing plan of the account.
+func isEscalationPolicyAccessDeniedError(err error) bool {
+	var apiErr *pagerduty.Error
+	if !errors.As(err, &apiErr) || apiErr.ErrorResponse == nil || apiErr.ErrorResponse.Response == nil {
+		return false
+	}
+
+	return apiErr.ErrorResponse.Response.StatusCode == http.StatusForbidden && apiErr.Code == pagerDutyAccessDeniedErrorCode
+}
+
+// explainEscalationPolicyAccessDenied adds what's required to change an
+// escalation policy to the access denied errors of the API, other errors are
+// returned as they are.
+func explainEscalationPolicyAccessDenied(err error, action string) error {
+	if !isEscalationPolicyAccessDeniedError(err) {
+		return err
+	}
+
+	return fmt.Errorf("the API token is not allowed to %s: on accounts with Advanced Permissions the user of the token needs the Manager role on the teams of the escalation policy, or the Global Admin or Account Owner base role, to change it: %w", action, err)
+}
+
 func expandEscalationRules(v interface{}) []*pagerduty.EscalationRule {
 	var escalationRules []*pagerduty.EscalationRule
 
@@ -399,9 +908,12 @@ func expandTeams(v interface{}) []*pagerduty.TeamReference {
 }
 
 func flattenTeams(teams []*pagerduty.TeamReference) []string {
-	res := make([]string, len(teams))
-	for i, t := range teams {
-		res[i] = t.ID
+	res := make([]string, 0, len(teams))
+	for _, t := range teams {
+		if t == nil {
+			continue
+		}
+		res = append(res, t.ID)
 	}
 
 	return res
@@ -1,12 +1,21 @@
 package pagerduty
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -66,6 +75,10 @@ func TestAccPagerDutyEscalationPolicy_Basic(t *testing.T) {
 				Config: testAccCheckPagerDutyEscalationPolicyConfig(username, email, escalationPolicy),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPagerDutyEscalationPolicyExists("pagerduty_escalation_policy.foo"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_escalation_policy.foo", "html_url"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_escalation_policy.foo", "self"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_escalation_policy.foo", "name", escalationPolicy),
 					resource.TestCheckResourceAttr(
@@ -109,6 +122,70 @@ func TestAccPagerDutyEscalationPolicy_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyEscalationPolicy_NoRepeat(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyNumLoopsConfig(username, email, escalationPolicy, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEscalationPolicyExists("pagerduty_escalation_policy.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "num_loops", "0"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "repeat_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyNumLoopsConfig(username, email, escalationPolicy, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "num_loops", "2"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "repeat_enabled", "true"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyEscalationPolicyNumLoopsConfig(username, email, escalationPolicy, 10),
+				ExpectError: regexp.MustCompile(`expected num_loops to be in the range \(0 - 9\)`),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyEscalationPolicy_NumLoopsUnset(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyNumLoopsUnsetConfig(username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEscalationPolicyExists("pagerduty_escalation_policy.foo"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_escalation_policy.foo", "num_loops"),
+				),
+			},
+			{
+				Config:             testAccCheckPagerDutyEscalationPolicyNumLoopsUnsetConfig(username, email, escalationPolicy),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyEscalationPolicyWithRoundRobinAssignmentStrategy(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -160,6 +237,73 @@ func TestAccPagerDutyEscalationPolicyWithRoundRobinAssignmentStrategy(t *testing
 	})
 }
 
+func TestAccPagerDutyEscalationPolicy_RulesValidation(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyEscalationPolicyWithoutRulesConfig(username, email, escalationPolicy),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("escalation policy must have at least one rule"),
+			},
+			{
+				Config:      testAccCheckPagerDutyEscalationPolicyWithRuleWithoutTargetsConfig(username, email, escalationPolicy),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`rule\.1 of escalation policy must have at least one target`),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyEscalationPolicy_TargetNames(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	start := timeNowInLoc("America/New_York").Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyEscalationPolicyWithTargetIDAndNameConfig(username, email, escalationPolicy),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`rule\.0\.target\.0 of escalation policy must have exactly one of id or name`),
+			},
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyWithTargetNamesConfig(username, email, schedule, escalationPolicy, start),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEscalationPolicyExists("pagerduty_escalation_policy.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "rule.0.target.0.name", username),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_escalation_policy.foo", "rule.0.target.0.id", "pagerduty_user.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "rule.0.target.1.name", schedule),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_escalation_policy.foo", "rule.0.target.1.id", "pagerduty_schedule.foo", "id"),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyEscalationPolicyWithTargetNamesConfig(username, email, schedule, escalationPolicy, start),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccCheckPagerDutyEscalationPolicyWithAmbiguousTargetNameConfig(username, email, schedule, escalationPolicy, start),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("2 users are named %s", username)),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyEscalationPolicy_FormatValidation(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -264,6 +408,347 @@ func TestAccPagerDutyEscalationPolicyWithTeams_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyEscalationPolicyWithTeams_RemoveTeams(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyWithTeamsConfig(username, email, team, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "teams.#", "1"),
+					testAccCheckPagerDutyEscalationPolicyTeamsCount("pagerduty_escalation_policy.foo", 1),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyWithTeamsConfigUpdated(username, email, team, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "teams.#", "0"),
+					testAccCheckPagerDutyEscalationPolicyTeamsCount("pagerduty_escalation_policy.foo", 0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyEscalationPolicy_InvalidTargetType(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyEscalationPolicyInvalidTargetTypeConfig(username, email, escalationPolicy),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`rule.1.target.1.type of escalation policy: "user" is an invalid value`),
+			},
+		},
+	})
+}
+
+func TestValidateEscalationRuleTargetType(t *testing.T) {
+	p := cty.GetAttrPath("rule").IndexInt(1).GetAttr("target").IndexInt(0).GetAttr("type")
+
+	if diags := validateEscalationRuleTargetType("schedule_reference", p); diags.HasError() {
+		t.Errorf("expected schedule_reference to be valid, got %v", diags)
+	}
+
+	diags := validateEscalationRuleTargetType("schedule", p)
+	if !diags.HasError() {
+		t.Fatal("expected schedule to be invalid")
+	}
+	if expected := `rule.1.target.0.type of escalation policy: "schedule" is an invalid value`; !strings.HasPrefix(diags[0].Summary, expected) {
+		t.Errorf("expected the error to start with %q, got %q", expected, diags[0].Summary)
+	}
+}
+
+func testAccCheckPagerDutyEscalationPolicyInvalidTargetTypeConfig(name, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+    target {
+      type = "user"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+`, name, email, escalationPolicy)
+}
+
+func TestResourcePagerDutyEscalationPolicyUpdate_AccessDenied(t *testing.T) {
+	cases := []struct {
+		name      string
+		errorBody string
+		explained bool
+	}{
+		{
+			name:      "access denied",
+			errorBody: `{"error":{"code":2010,"message":"Access Denied","errors":["You are not authorized to modify this escalation policy"]}}`,
+			explained: true,
+		},
+		{
+			name:      "other forbidden error",
+			errorBody: `{"error":{"code":2001,"message":"Forbidden"}}`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(c.errorBody))
+			}))
+			defer server.Close()
+
+			config := &Config{
+				Token:               "foo",
+				ApiUrlOverride:      server.URL,
+				SkipCredsValidation: true,
+			}
+
+			r := resourcePagerDutyEscalationPolicy()
+			d := r.TestResourceData()
+			d.SetId("PEP1234")
+			d.Set("name", "foo")
+			d.Set("rule", []interface{}{map[string]interface{}{
+				"escalation_delay_in_minutes": 10,
+				"target": []interface{}{map[string]interface{}{
+					"type": "user_reference",
+					"id":   "PUSER01",
+				}},
+			}})
+
+			err := resourcePagerDutyEscalationPolicyUpdate(d, config)
+			if err == nil {
+				t.Fatal("expected an error updating the escalation policy")
+			}
+			var apiErr *pagerduty.Error
+			if !errors.As(err, &apiErr) {
+				t.Errorf("expected the error of the API to be kept, got %v", err)
+			}
+
+			explained := strings.Contains(err.Error(), "Advanced Permissions")
+			if explained != c.explained {
+				t.Errorf("expected the RBAC requirement to be explained: %t, got %q", c.explained, err)
+			}
+		})
+	}
+}
+
+func TestAccPagerDutyEscalationPolicy_DefaultTeam(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The default team has to be known when the provider is
+				// configured, so it's created ahead.
+				Config: testAccCheckPagerDutyEscalationPolicyDefaultTeamTeamsConfig(team),
+			},
+			{
+				Config: testAccCheckPagerDutyEscalationPolicyDefaultTeamConfig(username, email, team, escalationPolicy, service),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.foo", "teams.#", "0"),
+					testAccCheckPagerDutyEscalationPolicyHasTeam("pagerduty_escalation_policy.foo", "pagerduty_team.default"),
+					testAccCheckPagerDutyServiceHasTeam("pagerduty_service.foo", "pagerduty_team.default"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_escalation_policy.bar", "teams.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_escalation_policy.bar", "teams.0", "pagerduty_team.other", "id"),
+					testAccCheckPagerDutyEscalationPolicyTeamsCount("pagerduty_escalation_policy.bar", 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyEscalationPolicyHasTeam(n, team string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		t, ok := s.RootModule().Resources[team]
+		if !ok {
+			return fmt.Errorf("Not found: %s", team)
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.EscalationPolicies.Get(rs.Primary.ID, &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			return err
+		}
+
+		if len(found.Teams) != 1 || found.Teams[0].ID != t.Primary.ID {
+			return fmt.Errorf("Expected escalation policy %s to have the team %s in PagerDuty, got %v", rs.Primary.ID, t.Primary.ID, flattenTeams(found.Teams))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyServiceHasTeam(n, team string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		t, ok := s.RootModule().Resources[team]
+		if !ok {
+			return fmt.Errorf("Not found: %s", team)
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.Services.Get(rs.Primary.ID, &pagerduty.GetServiceOptions{})
+		if err != nil {
+			return err
+		}
+
+		for _, st := range found.Teams {
+			if st.ID == t.Primary.ID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Expected service %s to have the team %s in PagerDuty, got %v", rs.Primary.ID, t.Primary.ID, flattenTeams(found.Teams))
+	}
+}
+
+func testAccCheckPagerDutyEscalationPolicyDefaultTeamTeamsConfig(team string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "default" {
+  name = "%[1]s"
+}
+
+resource "pagerduty_team" "other" {
+  name = "%[1]s-other"
+}
+`, team)
+}
+
+func testAccCheckPagerDutyEscalationPolicyDefaultTeamConfig(name, email, team, escalationPolicy, service string) string {
+	return fmt.Sprintf(`
+provider "pagerduty" {
+  default_team = pagerduty_team.default.id
+}
+
+resource "pagerduty_team" "default" {
+  name = "%[3]s"
+}
+
+resource "pagerduty_team" "other" {
+  name = "%[3]s-other"
+}
+
+resource "pagerduty_user" "foo" {
+  name        = "%[1]s"
+  email       = "%[2]s"
+  color       = "green"
+  role        = "user"
+  job_title   = "foo"
+  description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[4]s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_escalation_policy" "bar" {
+  name      = "%[4]s-bar"
+  num_loops = 1
+  teams     = [pagerduty_team.other.id]
+
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[5]s"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+`, name, email, team, escalationPolicy, service)
+}
+
+func testAccCheckPagerDutyEscalationPolicyTeamsCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		found, _, err := client.EscalationPolicies.Get(rs.Primary.ID, &pagerduty.GetEscalationPolicyOptions{})
+		if err != nil {
+			return err
+		}
+
+		if len(found.Teams) != expected {
+			return fmt.Errorf("Expected escalation policy %s to have %d teams in PagerDuty, got %d", rs.Primary.ID, expected, len(found.Teams))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckPagerDutyEscalationPolicyWithRoundRoundAssignmentStrategyConfig(name, email, escalationPolicy, strategy string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -494,3 +979,329 @@ resource "pagerduty_escalation_policy" "foo" {
 }
 `, name, email, team, escalationPolicy)
 }
+
+func testAccCheckPagerDutyEscalationPolicyWithoutRulesConfig(name, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  dynamic "rule" {
+    for_each = []
+    content {
+      escalation_delay_in_minutes = 10
+
+      target {
+        type = "user_reference"
+        id   = pagerduty_user.foo.id
+      }
+    }
+  }
+}
+`, name, email, escalationPolicy)
+}
+
+func testAccCheckPagerDutyEscalationPolicyWithRuleWithoutTargetsConfig(name, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+
+  rule {
+    escalation_delay_in_minutes = 20
+
+    dynamic "target" {
+      for_each = []
+      content {
+        type = "user_reference"
+        id   = pagerduty_user.foo.id
+      }
+    }
+  }
+}
+`, name, email, escalationPolicy)
+}
+
+func testAccCheckPagerDutyEscalationPolicyWithTargetIDAndNameConfig(name, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name = "%s"
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+      name = pagerduty_user.foo.name
+    }
+  }
+}
+`, name, email, escalationPolicy)
+}
+
+func testAccCheckPagerDutyEscalationPolicyWithTargetNamesConfig(name, email, schedule, escalationPolicy, start string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]s"
+  email = "%[2]s"
+}
+
+resource "pagerduty_schedule" "foo" {
+  name      = "%[3]s"
+  time_zone = "America/New_York"
+
+  layer {
+    name                         = "foo"
+    start                        = "%[5]s"
+    rotation_virtual_start       = "%[5]s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.foo.id]
+  }
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name = "%[4]s"
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      name = pagerduty_user.foo.name
+    }
+    target {
+      type = "schedule_reference"
+      name = pagerduty_schedule.foo.name
+    }
+  }
+}
+`, name, email, schedule, escalationPolicy, start)
+}
+
+func testAccCheckPagerDutyEscalationPolicyWithAmbiguousTargetNameConfig(name, email, schedule, escalationPolicy, start string) string {
+	return testAccCheckPagerDutyEscalationPolicyWithTargetNamesConfig(name, email, schedule, escalationPolicy, start) + fmt.Sprintf(`
+resource "pagerduty_user" "bar" {
+  name  = "%[1]s"
+  email = "bar.%[2]s"
+}
+
+resource "pagerduty_escalation_policy" "bar" {
+  name = "%[3]s-bar"
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      name = pagerduty_user.bar.name
+    }
+  }
+}
+`, name, email, escalationPolicy)
+}
+
+func testAccCheckPagerDutyEscalationPolicyNumLoopsUnsetConfig(name, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name = "%s"
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+`, name, email, escalationPolicy)
+}
+
+func testAccCheckPagerDutyEscalationPolicyNumLoopsConfig(name, email, escalationPolicy string, numLoops int) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%s"
+  num_loops = %d
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+`, name, email, escalationPolicy, numLoops)
+}
+
+func TestCustomizePagerDutyEscalationPolicyDiff_TargetNames(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/users" && r.URL.Query().Get("query") == "Nobody":
+			w.Write([]byte(`{"users":[],"more":false}`))
+		case r.URL.Path == "/users" && r.URL.Query().Get("offset") == "":
+			w.Write([]byte(`{"users":[{"id":"PUSER01","name":"Earline Greenholt Jr"}],"offset":0,"more":true}`))
+		case r.URL.Path == "/users":
+			w.Write([]byte(`{"users":[{"id":"PUSER02","name":"Earline Greenholt"}],"offset":1,"more":false}`))
+		case r.URL.Path == "/schedules":
+			w.Write([]byte(`{"schedules":[{"id":"PSCHED1","name":"primary"},{"id":"PSCHED2","name":"primary"}],"more":false}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := resourcePagerDutyEscalationPolicy()
+	diff := func(targetType, name string) error {
+		raw := sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": "foo",
+			"rule": []interface{}{
+				map[string]interface{}{
+					"escalation_delay_in_minutes": 10,
+					"target": []interface{}{
+						map[string]interface{}{"type": targetType, "name": name},
+					},
+				},
+			},
+		})
+		_, err := r.Diff(context.Background(), nil, raw, config)
+		return err
+	}
+
+	if err := diff("user_reference", "Earline Greenholt"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id, ok := config.cachedReference("user_reference", "Earline Greenholt"); !ok || id != "PUSER02" {
+		t.Errorf("expected the user named Earline Greenholt to be found on the second page at plan time, got %v", id)
+	}
+
+	err := diff("schedule_reference", "primary")
+	if err == nil || !strings.Contains(err.Error(), "rule.0.target.0 of escalation policy: 2 schedules are named primary (PSCHED1, PSCHED2)") {
+		t.Errorf("expected the ambiguous schedule name to fail the plan, got %v", err)
+	}
+
+	if err := diff("user_reference", "Nobody"); err != nil {
+		t.Errorf("expected the users not found yet to be left to the apply, got %v", err)
+	}
+}
+
+func TestResourcePagerDutyEscalationPolicy_OnCallHandoffNotifications(t *testing.T) {
+	for _, mode := range []string{"if_has_services", "always"} {
+		t.Run(mode, func(t *testing.T) {
+			current := "if_has_services"
+			var sent []string
+			gets := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path != "/escalation_policies/PEP1234" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				switch r.Method {
+				case http.MethodPut:
+					var v escalationPolicyOnCallHandoffNotifications
+					if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
+						t.Errorf("unexpected error decoding the request: %v", err)
+					}
+					current = v.EscalationPolicy.OnCallHandoffNotifications
+					sent = append(sent, current)
+				case http.MethodGet:
+					gets++
+					if include := r.URL.Query()["include[]"]; len(include) != 1 || include[0] != "escalation_rule_assignment_strategies" {
+						t.Errorf("expected the assignment strategies to be included, got %v", include)
+					}
+				}
+				fmt.Fprintf(w, `{"escalation_policy":{"id":"PEP1234","name":"foo","num_loops":1,"on_call_handoff_notifications":%q,
+					"escalation_rules":[{"id":"PRULE01","escalation_delay_in_minutes":10,"targets":[{"id":"PUSER01","type":"user_reference"}]}]}}`, current)
+			}))
+			defer server.Close()
+
+			config := &Config{Token: "foo", ApiUrlOverride: server.URL, SkipCredsValidation: true}
+			client, err := config.Client()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			r := resourcePagerDutyEscalationPolicy()
+			d := r.TestResourceData()
+			d.SetId("PEP1234")
+			if err := updateEscalationPolicyOnCallHandoffNotifications(client, d); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(sent) > 0 {
+				t.Errorf("expected on_call_handoff_notifications not to be sent when it isn't configured, got %v", sent)
+			}
+			if err := fetchEscalationPolicy(d, config, genError); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if v := d.Get("on_call_handoff_notifications").(string); v != "if_has_services" {
+				t.Errorf("expected the default of the API to be read back, got %q", v)
+			}
+			if v := d.Get("name").(string); v != "foo" {
+				t.Errorf("expected the policy to be read from the same response, got name %q", v)
+			}
+			if gets != 1 {
+				t.Errorf("expected the policy to be fetched once, got %d requests", gets)
+			}
+
+			d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"on_call_handoff_notifications": mode})
+			d.SetId("PEP1234")
+			if err := updateEscalationPolicyOnCallHandoffNotifications(client, d); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(sent) != 1 || sent[0] != mode {
+				t.Errorf("expected on_call_handoff_notifications %q to be sent, got %v", mode, sent)
+			}
+			if err := fetchEscalationPolicy(d, config, genError); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if v := d.Get("on_call_handoff_notifications").(string); v != mode {
+				t.Errorf("expected on_call_handoff_notifications %q to be read back, got %q", mode, v)
+			}
+		})
+	}
+}
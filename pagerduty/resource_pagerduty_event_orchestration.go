@@ -56,8 +56,9 @@ func resourcePagerDutyEventOrchestration() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"routing_key": {
-										Type:     schema.TypeString,
-										Computed: true,
+										Type:      schema.TypeString,
+										Computed:  true,
+										Sensitive: true,
 									},
 									"type": {
 										Type:     schema.TypeString,
@@ -73,7 +74,7 @@ func resourcePagerDutyEventOrchestration() *schema.Resource {
 	}
 }
 
-func buildEventOrchestrationStruct(d *schema.ResourceData) *pagerduty.EventOrchestration {
+func buildEventOrchestrationStruct(d *schema.ResourceData, meta interface{}) *pagerduty.EventOrchestration {
 	orchestration := &pagerduty.EventOrchestration{
 		Name: d.Get("name").(string),
 	}
@@ -82,9 +83,9 @@ func buildEventOrchestrationStruct(d *schema.ResourceData) *pagerduty.EventOrche
 		orchestration.Description = attr.(string)
 	}
 
-	if attr, ok := d.GetOk("team"); ok {
+	if team := applyDefaultTeamID(d.Get("team").(string), meta); team != "" {
 		orchestration.Team = &pagerduty.EventOrchestrationObject{
-			ID: stringTypeToStringPtr(attr.(string)),
+			ID: stringTypeToStringPtr(team),
 		}
 	} else {
 		var tId *string
@@ -102,7 +103,7 @@ func resourcePagerDutyEventOrchestrationCreate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	payload := buildEventOrchestrationStruct(d)
+	payload := buildEventOrchestrationStruct(d, meta)
 	var orchestration *pagerduty.EventOrchestration
 
 	log.Printf("[INFO] Creating PagerDuty Event Orchestration: %s", payload.Name)
@@ -125,7 +126,7 @@ func resourcePagerDutyEventOrchestrationCreate(d *schema.ResourceData, meta inte
 		return retryErr
 	}
 
-	setEventOrchestrationProps(d, orchestration)
+	setEventOrchestrationProps(d, orchestration, meta)
 
 	return nil
 }
@@ -152,7 +153,7 @@ func resourcePagerDutyEventOrchestrationRead(d *schema.ResourceData, meta interf
 			return nil
 		}
 
-		setEventOrchestrationProps(d, orch)
+		setEventOrchestrationProps(d, orch, meta)
 
 		return nil
 	})
@@ -164,17 +165,22 @@ func resourcePagerDutyEventOrchestrationUpdate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	orchestration := buildEventOrchestrationStruct(d)
+	orchestration := buildEventOrchestrationStruct(d, meta)
 
 	log.Printf("[INFO] Updating PagerDuty Event Orchestration: %s", d.Id())
 
+	// The team is changed in place, the orchestration keeps its ID and the
+	// routing keys of its integrations.
+	var updated *pagerduty.EventOrchestration
 	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
-		if _, _, err := client.EventOrchestrations.Update(d.Id(), orchestration); err != nil {
+		orch, _, err := client.EventOrchestrations.Update(d.Id(), orchestration)
+		if err != nil {
 			if isErrCode(err, 400) || isErrCode(err, 429) {
 				return retry.RetryableError(err)
 			}
 			return retry.NonRetryableError(err)
 		}
+		updated = orch
 
 		return nil
 	})
@@ -183,6 +189,10 @@ func resourcePagerDutyEventOrchestrationUpdate(d *schema.ResourceData, meta inte
 		return retryErr
 	}
 
+	if updated != nil {
+		setEventOrchestrationProps(d, updated, meta)
+	}
+
 	return nil
 }
 
@@ -224,13 +234,15 @@ func flattenEventOrchestrationIntegrations(eoi []*pagerduty.EventOrchestrationIn
 	return result
 }
 
-func setEventOrchestrationProps(d *schema.ResourceData, o *pagerduty.EventOrchestration) error {
+func setEventOrchestrationProps(d *schema.ResourceData, o *pagerduty.EventOrchestration, meta interface{}) error {
 	d.Set("name", o.Name)
 	d.Set("description", o.Description)
 	d.Set("routes", o.Routes)
 
-	if o.Team != nil {
+	if o.Team != nil && o.Team.ID != nil && !isUnconfiguredDefaultTeam(d, "team", *o.Team.ID, meta) {
 		d.Set("team", o.Team.ID)
+	} else {
+		d.Set("team", "")
 	}
 
 	if len(o.Integrations) > 0 {
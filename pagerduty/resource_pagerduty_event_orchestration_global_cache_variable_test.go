@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -49,16 +50,57 @@ func TestAccPagerDutyEventOrchestrationGlobalCacheVariable_Basic(t *testing.T) {
 	disabled1 := "false"
 	disabled2 := "true"
 
+	invalidConfigs := []struct {
+		config string
+		err    string
+	}{
+		{`
+		configuration {
+  		type = "recent_value"
+  		source = "event.summary"
+    }
+  `, "regex and source cannot be null when type is recent_value"},
+		{`
+		configuration {
+  		type = "trigger_event_count"
+    }
+  `, "ttl_seconds cannot be null when type is trigger_event_count"},
+		{`
+		configuration {
+  		type = "recent_value"
+  		source = "summary"
+  		regex = ".*"
+    }
+  `, `"summary" is not a valid path to an event field`},
+		{`
+		configuration {
+  		type = "recent_value"
+  		source = "event.summary"
+  		regex = "(.*"
+    }
+  `, `regex "\(\.\*" is not a valid regular expression`},
+	}
+	steps := []resource.TestStep{}
+	for _, c := range invalidConfigs {
+		steps = append(steps, resource.TestStep{
+			Config:      testAccCheckPagerDutyEventOrchestrationGlobalCacheVariableConfig(orch, name1, orchn1, disabled1, c.config, cond1),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(c.err),
+		})
+	}
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckPagerDutyEventOrchestrationGlobalCacheVariableDestroy,
-		Steps: []resource.TestStep{
+		Steps: append(steps, []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationGlobalCacheVariableConfig(orch, name1, orchn1, disabled1, config1, cond1),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPagerDutyEventOrchestrationGlobalCacheVariableID(cv, orchn1),
 					resource.TestCheckResourceAttr(cv, "name", name1),
+					resource.TestCheckResourceAttr(cv, "configuration.0.type", "trigger_event_count"),
+					resource.TestCheckResourceAttr(cv, "configuration.0.ttl_seconds", "60"),
 				),
 			},
 			// update name and disabled state:
@@ -102,7 +144,7 @@ func TestAccPagerDutyEventOrchestrationGlobalCacheVariable_Basic(t *testing.T) {
 					testAccCheckPagerDutyEventOrchestrationGlobalCacheVariableExistsNot(cv),
 				),
 			},
-		},
+		}...),
 	})
 }
 
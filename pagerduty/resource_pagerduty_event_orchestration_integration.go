@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 func resourcePagerDutyEventOrchestrationIntegration() *schema.Resource {
@@ -42,8 +43,9 @@ func resourcePagerDutyEventOrchestrationIntegration() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"routing_key": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
 						},
 						"type": {
 							Type:     schema.TypeString,
@@ -116,7 +118,7 @@ func resourcePagerDutyEventOrchestrationIntegrationCreate(ctx context.Context, d
 	})
 
 	if retryErr != nil {
-		time.Sleep(2 * time.Second)
+		util.SleepContext(ctx, 2*time.Second)
 		return diag.FromErr(retryErr)
 	}
 
@@ -191,7 +193,7 @@ func resourcePagerDutyEventOrchestrationIntegrationUpdate(ctx context.Context, d
 		})
 
 		if retryErr != nil {
-			time.Sleep(2 * time.Second)
+			util.SleepContext(ctx, 2*time.Second)
 			return diag.FromErr(retryErr)
 		}
 	}
@@ -219,7 +221,7 @@ func resourcePagerDutyEventOrchestrationIntegrationUpdate(ctx context.Context, d
 		})
 
 		if retryErr != nil {
-			time.Sleep(2 * time.Second)
+			util.SleepContext(ctx, 2*time.Second)
 			return diag.FromErr(retryErr)
 		}
 	}
@@ -255,7 +257,7 @@ func resourcePagerDutyEventOrchestrationIntegrationDelete(ctx context.Context, d
 	})
 
 	if retryErr != nil {
-		time.Sleep(2 * time.Second)
+		util.SleepContext(ctx, 2*time.Second)
 		return diag.FromErr(retryErr)
 	}
 
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 var eventOrchestrationPathGlobalCatchAllActionsSchema = map[string]*schema.Schema{
@@ -51,20 +52,8 @@ var eventOrchestrationPathGlobalCatchAllActionsSchema = map[string]*schema.Schem
 		Optional:         true,
 		ValidateDiagFunc: validateEventOrchestrationPathEventAction(),
 	},
-	"variable": {
-		Type:     schema.TypeList,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: eventOrchestrationPathVariablesSchema,
-		},
-	},
-	"extraction": {
-		Type:     schema.TypeList,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: eventOrchestrationPathExtractionsSchema,
-		},
-	},
+	"variable":   eventOrchestrationPathVariableActionSchema,
+	"extraction": eventOrchestrationPathExtractionActionSchema,
 	"incident_custom_field_update": {
 		Type:     schema.TypeList,
 		Optional: true,
@@ -99,7 +88,7 @@ func resourcePagerDutyEventOrchestrationPathGlobal() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourcePagerDutyEventOrchestrationPathGlobalImport,
 		},
-		CustomizeDiff: checkExtractions,
+		CustomizeDiff: customizeDiffAll(checkExtractions, checkEventOrchestrationPathConditions, checkEventOrchestrationPathPriorities, checkEventOrchestrationPathIncidentCustomFields),
 		Schema: map[string]*schema.Schema{
 			"event_orchestration": {
 				Type:     schema.TypeString,
@@ -191,10 +180,13 @@ func resourcePagerDutyEventOrchestrationPathGlobalRead(ctx context.Context, d *s
 				return retry.NonRetryableError(err)
 			}
 
-			time.Sleep(2 * time.Second)
+			util.SleepContext(ctx, 2*time.Second)
 			return retry.RetryableError(err)
 		} else if path != nil {
+			customFieldNames := eventOrchestrationPathIncidentCustomFieldNames(d)
+			preserveEventOrchestrationPathPriorityRefs(meta.(*Config), d, path)
 			setEventOrchestrationPathGlobalProps(d, path)
+			keepEventOrchestrationPathIncidentCustomFieldNames(ctx, meta.(*Config), d, customFieldNames)
 		}
 		return nil
 	})
@@ -219,6 +211,12 @@ func resourcePagerDutyEventOrchestrationPathGlobalUpdate(ctx context.Context, d
 	}
 
 	payload := buildGlobalPathStruct(d)
+	if err := resolveEventOrchestrationPathPriorities(meta.(*Config), payload); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := resolveEventOrchestrationPathIncidentCustomFields(ctx, meta.(*Config), d, payload); err != nil {
+		return diag.FromErr(err)
+	}
 	var globalPath *pagerduty.EventOrchestrationPath
 	var warnings []*pagerduty.EventOrchestrationPathWarning
 
@@ -243,7 +241,10 @@ func resourcePagerDutyEventOrchestrationPathGlobalUpdate(ctx context.Context, d
 		return diag.FromErr(retryErr)
 	}
 
+	customFieldNames := eventOrchestrationPathIncidentCustomFieldNames(d)
+	preserveEventOrchestrationPathPriorityRefs(meta.(*Config), d, globalPath)
 	setEventOrchestrationPathGlobalProps(d, globalPath)
+	keepEventOrchestrationPathIncidentCustomFieldNames(ctx, meta.(*Config), d, customFieldNames)
 
 	return convertEventOrchestrationPathWarningsToDiagnostics(warnings, diags)
 }
@@ -383,8 +384,7 @@ func expandGlobalPathActions(v interface{}) *pagerduty.EventOrchestrationPathRul
 		actions.Severity = a["severity"].(string)
 		actions.EventAction = a["event_action"].(string)
 		actions.AutomationActions = expandEventOrchestrationPathAutomationActions(a["automation_action"])
-		actions.Variables = expandEventOrchestrationPathVariables(a["variable"])
-		actions.Extractions = expandEventOrchestrationPathExtractions(a["extraction"])
+		expandEventOrchestrationPathVariablesAndExtractions(a, actions)
 		actions.IncidentCustomFieldUpdates = expandEventOrchestrationPathIncidentCustomFields(a["incident_custom_field_update"])
 	}
 
@@ -455,12 +455,7 @@ func flattenGlobalPathActions(actions *pagerduty.EventOrchestrationPathRuleActio
 		"escalation_policy": stringPtrToStringType(actions.EscalationPolicy),
 	}
 
-	if actions.Variables != nil {
-		flattenedAction["variable"] = flattenEventOrchestrationPathVariables(actions.Variables)
-	}
-	if actions.Extractions != nil {
-		flattenedAction["extraction"] = flattenEventOrchestrationPathExtractions(actions.Extractions)
-	}
+	flattenEventOrchestrationPathVariablesAndExtractions(actions, flattenedAction)
 	if actions.AutomationActions != nil {
 		flattenedAction["automation_action"] = flattenEventOrchestrationAutomationActions(actions.AutomationActions)
 	}
@@ -176,6 +176,98 @@ func TestAccPagerDutyEventOrchestrationPathGlobal_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyEventOrchestrationPathGlobal_VariablesAndExtractionsCrossScope(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	orch := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	global := "pagerduty_event_orchestration_global.my_global_orch"
+	svc := "pagerduty_event_orchestration_service.serviceA"
+
+	var checks []resource.TestCheckFunc
+	for _, attr := range []string{
+		"set.0.rule.0.actions.0.variable.#",
+		"set.0.rule.0.actions.0.variable.0.name",
+		"set.0.rule.0.actions.0.variable.0.path",
+		"set.0.rule.0.actions.0.variable.0.type",
+		"set.0.rule.0.actions.0.variable.0.value",
+		"set.0.rule.0.actions.0.extraction.#",
+		"set.0.rule.0.actions.0.extraction.0.target",
+		"set.0.rule.0.actions.0.extraction.0.template",
+		"set.0.rule.0.actions.0.extraction.1.regex",
+		"set.0.rule.0.actions.0.extraction.1.source",
+		"set.0.rule.0.actions.0.extraction.1.target",
+	} {
+		checks = append(checks, resource.TestCheckResourceAttrPair(global, attr, svc, attr))
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationGlobalPathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathGlobalCrossScopeConfig(team, escalationPolicy, service, orch),
+				Check: resource.ComposeTestCheckFunc(append(checks,
+					testAccCheckPagerDutyEventOrchestrationGlobalExists(global),
+					testAccCheckPagerDutyEventOrchestrationPathServiceExists(svc),
+					resource.TestCheckResourceAttr(global, "set.0.rule.0.actions.0.variable.0.name", "hostname"),
+					resource.TestCheckResourceAttr(global, "set.0.rule.0.actions.0.extraction.#", "2"),
+				)...),
+			},
+			{
+				Config:   testAccCheckPagerDutyEventOrchestrationPathGlobalCrossScopeConfig(team, escalationPolicy, service, orch),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestEventOrchestrationPathVariablesAndExtractionsCrossScope(t *testing.T) {
+	actions := []interface{}{map[string]interface{}{
+		"route_to":          "",
+		"suppress":          false,
+		"suspend":           0,
+		"priority":          "",
+		"escalation_policy": "",
+		"annotate":          "",
+		"severity":          "",
+		"event_action":      "",
+		"drop_event":        false,
+		"variable": []interface{}{map[string]interface{}{
+			"name":  "hostname",
+			"path":  "event.source",
+			"type":  "regex",
+			"value": "Source host: (.*)",
+		}},
+		"extraction": []interface{}{
+			map[string]interface{}{"target": "event.summary", "template": "High CPU usage on {{variables.hostname}}", "regex": "", "source": ""},
+			map[string]interface{}{"target": "event.custom_details.message", "regex": ".*", "source": "event.group", "template": ""},
+		},
+		"automation_action":            []interface{}{},
+		"pagerduty_automation_action":  []interface{}{},
+		"incident_custom_field_update": []interface{}{},
+	}}
+
+	globalActions := flattenGlobalPathActions(expandGlobalPathActions(actions))[0]
+	serviceActions := flattenServicePathActions(expandServicePathActions(actions))[0]
+	unroutedActions := flattenUnroutedActions(expandUnroutedActions(actions))[0]
+
+	for _, attr := range []string{"variable", "extraction"} {
+		expected := fmt.Sprintf("%v", actions[0].(map[string]interface{})[attr])
+		for scope, flattened := range map[string]map[string]interface{}{
+			"global":   globalActions,
+			"service":  serviceActions,
+			"unrouted": unroutedActions,
+		} {
+			if got := fmt.Sprintf("%v", flattened[attr]); got != expected {
+				t.Errorf("expected the %s actions of the %s path to round-trip as %s, got %s", attr, scope, expected, got)
+			}
+		}
+	}
+}
+
 func testAccCheckPagerDutyEventOrchestrationGlobalPathDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -754,3 +846,61 @@ func testAccCheckPagerDutyEventOrchestrationPathGlobalOneSetNoActionsConfig(t, e
 func testAccCheckPagerDutyEventOrchestrationPathGlobalResourceDeleteConfig(t, ep, s, o string) string {
 	return createBaseGlobalOrchConfig(t, ep, s, o)
 }
+
+// eventOrchestrationPathCrossScopeActions are the variable and extraction
+// actions shared by the global and the service paths of the cross-scope test.
+const eventOrchestrationPathCrossScopeActions = `
+						variable {
+							name = "hostname"
+							path = "event.source"
+							type = "regex"
+							value = "Source host: (.*)"
+						}
+						extraction {
+							target = "event.summary"
+							template = "High CPU usage on {{variables.hostname}}"
+						}
+						extraction {
+							regex = ".*"
+							source = "event.group"
+							target = "event.custom_details.message"
+						}
+`
+
+func testAccCheckPagerDutyEventOrchestrationPathGlobalCrossScopeConfig(t, ep, s, o string) string {
+	return fmt.Sprintf(`%s
+		resource "pagerduty_event_orchestration_global" "my_global_orch" {
+			event_orchestration = pagerduty_event_orchestration.orch.id
+
+			set {
+				id = "start"
+				rule {
+					label = "cross scope rule"
+					actions {%[2]s
+					}
+				}
+			}
+
+			catch_all {
+				actions { }
+			}
+		}
+
+		resource "pagerduty_event_orchestration_service" "serviceA" {
+			service = pagerduty_service.bar.id
+
+			set {
+				id = "start"
+				rule {
+					label = "cross scope rule"
+					actions {%[2]s
+					}
+				}
+			}
+
+			catch_all {
+				actions { }
+			}
+		}
+	`, createBaseGlobalOrchConfig(t, ep, s, o), eventOrchestrationPathCrossScopeActions)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 func resourcePagerDutyEventOrchestrationPathRouter() *schema.Resource {
@@ -23,7 +24,7 @@ func resourcePagerDutyEventOrchestrationPathRouter() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourcePagerDutyEventOrchestrationPathRouterImport,
 		},
-		CustomizeDiff: checkDynamicRoutingRule,
+		CustomizeDiff: customizeDiffAll(checkDynamicRoutingRule, checkEventOrchestrationPathConditions),
 		Schema: map[string]*schema.Schema{
 			"event_orchestration": {
 				Type:     schema.TypeString,
@@ -122,8 +123,9 @@ func resourcePagerDutyEventOrchestrationPathRouter() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"route_to": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateCatchAllRouteTo,
 									},
 								},
 							},
@@ -135,6 +137,29 @@ func resourcePagerDutyEventOrchestrationPathRouter() *schema.Resource {
 	}
 }
 
+// catchAllUnrouted is the route_to of the catch-all sending the events to the
+// Unrouted Orchestration instead of a Service.
+const catchAllUnrouted = "unrouted"
+
+// validateCatchAllRouteTo checks route_to of the catch-all is either a Service
+// ID or "unrouted", values like "Unrouted" would be taken for a Service ID by
+// the API.
+func validateCatchAllRouteTo(v interface{}, key string) (warns []string, errs []error) {
+	value := v.(string)
+	if strings.TrimSpace(value) == "" {
+		errs = append(errs, fmt.Errorf("%s of the catch_all has to be a Service ID or %q, got an empty value", key, catchAllUnrouted))
+		return
+	}
+	if value != catchAllUnrouted && strings.EqualFold(strings.TrimSpace(value), catchAllUnrouted) {
+		errs = append(errs, fmt.Errorf("%s of the catch_all has to be a Service ID or %q. Got: %q, use %q to send the events to the Unrouted Orchestration", key, catchAllUnrouted, value, catchAllUnrouted))
+		return
+	}
+	if strings.ContainsAny(value, " \t\n") {
+		errs = append(errs, fmt.Errorf("%s of the catch_all has to be a Service ID or %q. Got: %q", key, catchAllUnrouted, value))
+	}
+	return
+}
+
 func checkDynamicRoutingRule(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
 	rNum := diff.Get("set.0.rule.#").(int)
 	draIdxs := []int{}
@@ -199,7 +224,7 @@ func resourcePagerDutyEventOrchestrationPathRouterRead(ctx context.Context, d *s
 				return retry.NonRetryableError(err)
 			}
 
-			time.Sleep(2 * time.Second)
+			util.SleepContext(ctx, 2*time.Second)
 			return retry.RetryableError(err)
 		} else if routerPath != nil {
 			d.Set("event_orchestration", routerPath.Parent.ID)
@@ -298,7 +323,7 @@ func resourcePagerDutyEventOrchestrationPathRouterUpdate(ctx context.Context, d
 	})
 
 	if retryErr != nil {
-		time.Sleep(2 * time.Second)
+		util.SleepContext(ctx, 2*time.Second)
 		return diag.FromErr(retryErr)
 	}
 
@@ -444,7 +469,13 @@ func flattenCatchAll(catchAll *pagerduty.EventOrchestrationPathCatchAll) []map[s
 
 	c := make(map[string]interface{})
 
-	c["actions"] = flattenRouterActions(catchAll.Actions)
+	// A catch-all without a route sends the events to the Unrouted
+	// Orchestration, it's the same as routing them to "unrouted".
+	if catchAll.Actions == nil || (catchAll.Actions.DynamicRouteTo == nil && catchAll.Actions.RouteTo == "") {
+		c["actions"] = []map[string]interface{}{{"route_to": catchAllUnrouted}}
+	} else {
+		c["actions"] = flattenRouterActions(catchAll.Actions)
+	}
 	caMap = append(caMap, c)
 
 	return caMap
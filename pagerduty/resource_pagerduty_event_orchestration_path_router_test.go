@@ -215,6 +215,89 @@ func TestAccPagerDutyEventOrchestrationPathRouter_EnableRoutingRule(t *testing.T
 	})
 }
 
+func TestAccPagerDutyEventOrchestrationPathRouter_CatchAllRouteTo(t *testing.T) {
+	team := fmt.Sprintf("tf-name-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationRouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationRouterCatchAllRouteToConfig(team, escalationPolicy, service, orchestration, `"Unrouted"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`use "unrouted" to send the events to the Unrouted Orchestration`),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationRouterCatchAllRouteToConfig(team, escalationPolicy, service, orchestration, `"unrouted"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationRouterExists("pagerduty_event_orchestration_router.router"),
+					testAccCheckPagerDutyEventOrchestrationRouterPathRouteToMatch(
+						"pagerduty_event_orchestration_router.router", "unrouted", true),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationRouterCatchAllRouteToConfig(team, escalationPolicy, service, orchestration, "pagerduty_service.bar.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationRouterExists("pagerduty_event_orchestration_router.router"),
+					testAccCheckPagerDutyEventOrchestrationRouterPathRouteToMatch(
+						"pagerduty_event_orchestration_router.router", "pagerduty_service.bar", true),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationRouterCatchAllRouteToConfig(team, escalationPolicy, service, orchestration, `"unrouted"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationRouterExists("pagerduty_event_orchestration_router.router"),
+					testAccCheckPagerDutyEventOrchestrationRouterPathRouteToMatch(
+						"pagerduty_event_orchestration_router.router", "unrouted", true),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateCatchAllRouteTo(t *testing.T) {
+	cases := map[string]bool{
+		"unrouted":  true,
+		"PARASOL":   true,
+		"Unrouted":  false,
+		"unrouted ": false,
+		"":          false,
+		" ":         false,
+		"P1 P2":     false,
+	}
+	for value, valid := range cases {
+		_, errs := validateCatchAllRouteTo(value, "route_to")
+		if valid && len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %v", value, errs)
+		}
+		if !valid && len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", value)
+		}
+	}
+}
+
+func TestFlattenCatchAllUnrouted(t *testing.T) {
+	for _, catchAll := range []*pagerduty.EventOrchestrationPathCatchAll{
+		{},
+		{Actions: &pagerduty.EventOrchestrationPathRuleActions{}},
+		{Actions: &pagerduty.EventOrchestrationPathRuleActions{RouteTo: "unrouted"}},
+	} {
+		got := flattenCatchAll(catchAll)[0]["actions"].([]map[string]interface{})[0]["route_to"]
+		if got != "unrouted" {
+			t.Errorf("expected the catch-all %#v to route to \"unrouted\", got %v", catchAll.Actions, got)
+		}
+	}
+
+	catchAll := &pagerduty.EventOrchestrationPathCatchAll{Actions: &pagerduty.EventOrchestrationPathRuleActions{RouteTo: "PARASOL"}}
+	if got := flattenCatchAll(catchAll)[0]["actions"].([]map[string]interface{})[0]["route_to"]; got != "PARASOL" {
+		t.Errorf("expected the catch-all to route to the service, got %v", got)
+	}
+}
+
 func testAccCheckPagerDutyEventOrchestrationRouterDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -607,6 +690,23 @@ func testAccCheckPagerDutyEventOrchestrationRouterConfigWithCatchAllToService(t,
 		`)
 }
 
+func testAccCheckPagerDutyEventOrchestrationRouterCatchAllRouteToConfig(t, ep, s, o, routeTo string) string {
+	return fmt.Sprintf("%s%s", createBaseConfig(t, ep, s, o), fmt.Sprintf(`
+		resource "pagerduty_event_orchestration_router" "router" {
+			event_orchestration = pagerduty_event_orchestration.orch.id
+
+			catch_all {
+				actions {
+					route_to = %s
+				}
+			}
+			set {
+				id = "start"
+			}
+		}
+	`, routeTo))
+}
+
 func testAccCheckPagerDutyEventOrchestrationRouterConfigDeleteAllRulesInSet(t, ep, s, o string) string {
 	return fmt.Sprintf("%s%s", createBaseConfig(t, ep, s, o),
 		`resource "pagerduty_event_orchestration_router" "router" {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 var eventOrchestrationPathServiceCatchAllActionsSchema = map[string]*schema.Schema{
@@ -61,20 +62,8 @@ var eventOrchestrationPathServiceCatchAllActionsSchema = map[string]*schema.Sche
 		Optional:         true,
 		ValidateDiagFunc: validateEventOrchestrationPathEventAction(),
 	},
-	"variable": {
-		Type:     schema.TypeList,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: eventOrchestrationPathVariablesSchema,
-		},
-	},
-	"extraction": {
-		Type:     schema.TypeList,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: eventOrchestrationPathExtractionsSchema,
-		},
-	},
+	"variable":   eventOrchestrationPathVariableActionSchema,
+	"extraction": eventOrchestrationPathExtractionActionSchema,
 	"incident_custom_field_update": {
 		Type:     schema.TypeList,
 		Optional: true,
@@ -109,7 +98,7 @@ func resourcePagerDutyEventOrchestrationPathService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourcePagerDutyEventOrchestrationPathServiceImport,
 		},
-		CustomizeDiff: checkExtractions,
+		CustomizeDiff: customizeDiffAll(checkExtractions, checkEventOrchestrationPathConditions, checkEventOrchestrationPathPriorities, checkEventOrchestrationPathIncidentCustomFields),
 		Schema: map[string]*schema.Schema{
 			"service": {
 				Type:     schema.TypeString,
@@ -215,7 +204,7 @@ func resourcePagerDutyEventOrchestrationPathServiceRead(ctx context.Context, d *
 				return nil
 			}
 
-			time.Sleep(2 * time.Second)
+			util.SleepContext(ctx, 2*time.Second)
 			return retry.RetryableError(err)
 		}
 
@@ -242,7 +231,7 @@ func resourcePagerDutyEventOrchestrationPathServiceRead(ctx context.Context, d *
 					return retry.NonRetryableError(err)
 				}
 
-				time.Sleep(2 * time.Second)
+				util.SleepContext(ctx, 2*time.Second)
 				return retry.RetryableError(err)
 			}
 			d.Set("enable_event_orchestration_for_service", pathServiceActiveStatus.Active)
@@ -255,7 +244,10 @@ func resourcePagerDutyEventOrchestrationPathServiceRead(ctx context.Context, d *
 	}
 
 	if path != nil {
+		customFieldNames := eventOrchestrationPathIncidentCustomFieldNames(d)
+		preserveEventOrchestrationPathPriorityRefs(meta.(*Config), d, path)
 		setEventOrchestrationPathServiceProps(d, path)
+		keepEventOrchestrationPathIncidentCustomFieldNames(ctx, meta.(*Config), d, customFieldNames)
 	}
 
 	return nil
@@ -274,7 +266,16 @@ func resourcePagerDutyEventOrchestrationPathServiceUpdate(ctx context.Context, d
 	}
 
 	payload := buildServicePathStruct(d)
+	if err := resolveEventOrchestrationPathPriorities(meta.(*Config), payload); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := resolveEventOrchestrationPathIncidentCustomFields(ctx, meta.(*Config), d, payload); err != nil {
+		return diag.FromErr(err)
+	}
 	serviceID := payload.Parent.ID
+	if err := validateServicePathPagerDutyAutomationActions(ctx, client, payload); err != nil {
+		return diag.FromErr(err)
+	}
 	var servicePath *pagerduty.EventOrchestrationPath
 	var warnings []*pagerduty.EventOrchestrationPathWarning
 
@@ -299,7 +300,10 @@ func resourcePagerDutyEventOrchestrationPathServiceUpdate(ctx context.Context, d
 		return diag.FromErr(retryErr)
 	}
 
+	customFieldNames := eventOrchestrationPathIncidentCustomFieldNames(d)
+	preserveEventOrchestrationPathPriorityRefs(meta.(*Config), d, servicePath)
 	setEventOrchestrationPathServiceProps(d, servicePath)
+	keepEventOrchestrationPathIncidentCustomFieldNames(ctx, meta.(*Config), d, customFieldNames)
 
 	if needToUpdateServiceActiveStatus(d) {
 		enableEOForService := d.Get("enable_event_orchestration_for_service").(bool)
@@ -315,11 +319,11 @@ func resourcePagerDutyEventOrchestrationPathServiceUpdate(ctx context.Context, d
 					return retry.NonRetryableError(err)
 				}
 
-				time.Sleep(2 * time.Second)
+				util.SleepContext(ctx, 2*time.Second)
 				return retry.RetryableError(err)
 			}
 			if resp.Active != enableEOForService {
-				time.Sleep(2 * time.Second)
+				util.SleepContext(ctx, 2*time.Second)
 				return retry.RetryableError(fmt.Errorf("incosistent result received when trying to update event orchestration active status for service %q", serviceID))
 			}
 			return nil
@@ -494,8 +498,7 @@ func expandServicePathActions(v interface{}) *pagerduty.EventOrchestrationPathRu
 		actions.EventAction = a["event_action"].(string)
 		actions.PagerdutyAutomationActions = expandServicePathPagerDutyAutomationActions(a["pagerduty_automation_action"])
 		actions.AutomationActions = expandEventOrchestrationPathAutomationActions(a["automation_action"])
-		actions.Variables = expandEventOrchestrationPathVariables(a["variable"])
-		actions.Extractions = expandEventOrchestrationPathExtractions(a["extraction"])
+		expandEventOrchestrationPathVariablesAndExtractions(a, actions)
 		actions.IncidentCustomFieldUpdates = expandEventOrchestrationPathIncidentCustomFields(a["incident_custom_field_update"])
 	}
 
@@ -517,6 +520,71 @@ func expandServicePathPagerDutyAutomationActions(v interface{}) []*pagerduty.Eve
 	return result
 }
 
+// validateServicePathPagerDutyAutomationActions checks the automation actions
+// invoked by the rules exist and are associated with the service, the API
+// accepts any action ID but the service can only invoke the actions which are
+// associated with it.
+func validateServicePathPagerDutyAutomationActions(ctx context.Context, client *pagerduty.Client, path *pagerduty.EventOrchestrationPath) error {
+	actions := []*pagerduty.EventOrchestrationPathRuleActions{path.CatchAll.Actions}
+	for _, set := range path.Sets {
+		for _, rule := range set.Rules {
+			actions = append(actions, rule.Actions)
+		}
+	}
+
+	serviceID := path.Parent.ID
+	checked := make(map[string]bool)
+	for _, a := range actions {
+		if a == nil {
+			continue
+		}
+		for _, pdaa := range a.PagerdutyAutomationActions {
+			if checked[pdaa.ActionId] {
+				continue
+			}
+			checked[pdaa.ActionId] = true
+
+			if err := checkServicePathPagerDutyAutomationAction(ctx, client, pdaa.ActionId, serviceID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func checkServicePathPagerDutyAutomationAction(ctx context.Context, client *pagerduty.Client, actionID, serviceID string) error {
+	log.Printf("[INFO] Checking PagerDuty automation action %s can be invoked by service %s", actionID, serviceID)
+
+	return retry.RetryContext(ctx, 30*time.Second, func() *retry.RetryError {
+		if _, _, err := client.AutomationActionsAction.Get(actionID); err != nil {
+			if isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(fmt.Errorf("automation action %s of pagerduty_automation_action doesn't exist", actionID))
+			}
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+
+			util.SleepContext(ctx, 2*time.Second)
+			return retry.RetryableError(err)
+		}
+
+		if _, _, err := client.AutomationActionsAction.GetAssociationToService(actionID, serviceID); err != nil {
+			if isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(fmt.Errorf("automation action %s of pagerduty_automation_action can't be invoked by service %s because it isn't associated with it, see pagerduty_automation_actions_action_service_association", actionID, serviceID))
+			}
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+
+			util.SleepContext(ctx, 2*time.Second)
+			return retry.RetryableError(err)
+		}
+
+		return nil
+	})
+}
+
 func setEventOrchestrationPathServiceProps(d *schema.ResourceData, p *pagerduty.EventOrchestrationPath) error {
 	d.SetId(p.Parent.ID)
 	d.Set("service", p.Parent.ID)
@@ -580,12 +648,7 @@ func flattenServicePathActions(actions *pagerduty.EventOrchestrationPathRuleActi
 		"escalation_policy": stringPtrToStringType(actions.EscalationPolicy),
 	}
 
-	if actions.Variables != nil {
-		flattenedAction["variable"] = flattenEventOrchestrationPathVariables(actions.Variables)
-	}
-	if actions.Extractions != nil {
-		flattenedAction["extraction"] = flattenEventOrchestrationPathExtractions(actions.Extractions)
-	}
+	flattenEventOrchestrationPathVariablesAndExtractions(actions, flattenedAction)
 	if actions.PagerdutyAutomationActions != nil {
 		flattenedAction["pagerduty_automation_action"] = flattenServicePathPagerDutyAutomationActions(actions.PagerdutyAutomationActions)
 	}
@@ -238,6 +238,171 @@ func TestAccPagerDutyEventOrchestrationPathService_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyEventOrchestrationPathService_VariablesAndExtractions(t *testing.T) {
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resourceName := "pagerduty_event_orchestration_service.serviceA"
+	ruleActions := "set.0.rule.0.actions.0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationServicePathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(
+					escalationPolicy, service, invalidExtractionTemplateSourceConfig(), "",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid configuration in set.0.rule.0.actions.0.extraction.0: source can only be used with regex"),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(
+					escalationPolicy, service, invalidVariablePathConfig(), "",
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"source" is not a valid path to an event field`),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceVariablesAndExtractionsConfig(escalationPolicy, service),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".variable.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".variable.0.name", "hostname"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".variable.0.path", "event.source"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".variable.0.value", "Source host: (.*)"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".variable.1.name", "cpu_val"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".variable.1.path", "event.custom_details.cpu"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".variable.2.name", "region"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".variable.2.path", "raw_event.region"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".extraction.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".extraction.0.target", "event.summary"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".extraction.0.template", "High CPU usage on {{variables.hostname}}: {{variables.cpu_val}}"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".extraction.1.target", "event.custom_details.message"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".extraction.1.regex", "host-(.*)"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".extraction.1.source", "variables.hostname"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".extraction.2.target", "event.group"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".extraction.2.regex", "(.*)"),
+					resource.TestCheckResourceAttr(resourceName, ruleActions+".extraction.2.source", "event.custom_details.region"),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyEventOrchestrationPathServiceVariablesAndExtractionsConfig(escalationPolicy, service),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyEventOrchestrationPathService_PriorityByName(t *testing.T) {
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resourceName := "pagerduty_event_orchestration_service.serviceA"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckPagerDutyAbility(t, "event_rules")
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationServicePathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationPathServicePriorityConfig(escalationPolicy, service, "not-a-priority"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`set.0.rule.0.actions.0.priority: Unable to locate any priority with the name: not-a-priority`),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServicePriorityConfig(escalationPolicy, service, "P1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.actions.0.priority", "P1"),
+					testAccCheckPagerDutyEventOrchestrationPathServicePriorityID(resourceName, "data.pagerduty_priority.p1"),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyEventOrchestrationPathServicePriorityConfig(escalationPolicy, service, "P1"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyEventOrchestrationPathService_IncidentCustomFieldByName(t *testing.T) {
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	field := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resourceName := "pagerduty_event_orchestration_service.serviceA"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckPagerDutyAbility(t, "event_rules")
+			testAccPreCheckIncidentCustomFieldTests(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationServicePathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceIncidentCustomFieldConfig(escalationPolicy, service, field, field+"_environment", "production"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.actions.0.incident_custom_field_update.0.name", field+"_environment"),
+					resource.TestCheckResourceAttrPair(resourceName, "set.0.rule.0.actions.0.incident_custom_field_update.0.id", "pagerduty_incident_custom_field.environment", "id"),
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.actions.0.incident_custom_field_update.0.value", "production"),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyEventOrchestrationPathServiceIncidentCustomFieldConfig(escalationPolicy, service, field, field+"_environment", "production"),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationPathServiceIncidentCustomFieldConfig(escalationPolicy, service, field, field+"_impacted_hosts", "many"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`set.0.rule.0.actions.0.incident_custom_field_update.0.value: "many" is not a valid value for the incident custom field`),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServiceIncidentCustomFieldConfig(escalationPolicy, service, field, field+"_impacted_hosts", "3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "set.0.rule.0.actions.0.incident_custom_field_update.0.name", field+"_impacted_hosts"),
+					resource.TestCheckResourceAttrPair(resourceName, "set.0.rule.0.actions.0.incident_custom_field_update.0.id", "pagerduty_incident_custom_field.impacted_hosts", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyEventOrchestrationPathService_PagerDutyAutomationAction(t *testing.T) {
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	resourceName := "pagerduty_event_orchestration_service.serviceA"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationServicePathDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyEventOrchestrationPathServicePagerDutyAutomationActionConfig(escalationPolicy, service, "pagerduty_automation_actions_action.baz.id"),
+				ExpectError: regexp.MustCompile("can't be invoked by service .* because it isn't associated with it"),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationPathServicePagerDutyAutomationActionConfig(escalationPolicy, service, "pagerduty_automation_actions_action_service_association.foo.action_id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationPathServiceExists(resourceName),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "set.0.rule.0.actions.0.pagerduty_automation_action.0.action_id",
+						"pagerduty_automation_actions_action.foo", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyEventOrchestrationServicePathDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -307,6 +472,100 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceServiceID(rn, sn string)
 	}
 }
 
+func testAccCheckPagerDutyEventOrchestrationPathServicePriorityID(rn, pn string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		orch, ok := s.RootModule().Resources[rn]
+		if !ok {
+			return fmt.Errorf("Not found: %s", rn)
+		}
+		priority, ok := s.RootModule().Resources[pn]
+		if !ok {
+			return fmt.Errorf("Not found: %s", pn)
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		found, _, err := client.EventOrchestrationPaths.GetContext(context.Background(), orch.Primary.ID, "service")
+		if err != nil {
+			return err
+		}
+		if got := found.Sets[0].Rules[0].Actions.Priority; got != priority.Primary.ID {
+			return fmt.Errorf("Expected the priority of the rule to be %s, got %s", priority.Primary.ID, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathServicePriorityConfig(ep, s, priority string) string {
+	return fmt.Sprintf("%s%s", createBaseServicePathConfig(ep, s),
+		fmt.Sprintf(`data "pagerduty_priority" "p1" {
+			name = "P1"
+		}
+
+		resource "pagerduty_event_orchestration_service" "serviceA" {
+			service = pagerduty_service.bar.id
+
+			set {
+				id = "start"
+				rule {
+					label = "set priority by name"
+					actions {
+						priority = "%s"
+					}
+				}
+			}
+
+			catch_all {
+				actions { }
+			}
+		}
+	`, priority))
+}
+
+func testAccCheckPagerDutyEventOrchestrationPathServiceIncidentCustomFieldConfig(ep, s, field, name, value string) string {
+	return fmt.Sprintf("%s%s", createBaseServicePathConfig(ep, s),
+		fmt.Sprintf(`resource "pagerduty_incident_custom_field" "environment" {
+			name         = "%[1]s_environment"
+			display_name = "%[1]s environment"
+			data_type    = "string"
+			field_type   = "single_value"
+		}
+
+		resource "pagerduty_incident_custom_field" "impacted_hosts" {
+			name         = "%[1]s_impacted_hosts"
+			display_name = "%[1]s impacted hosts"
+			data_type    = "integer"
+			field_type   = "single_value"
+		}
+
+		resource "pagerduty_event_orchestration_service" "serviceA" {
+			service = pagerduty_service.bar.id
+
+			set {
+				id = "start"
+				rule {
+					label = "set a custom field by name"
+					actions {
+						incident_custom_field_update {
+							name  = "%[2]s"
+							value = "%[3]s"
+						}
+					}
+				}
+			}
+
+			catch_all {
+				actions { }
+			}
+
+			depends_on = [
+				pagerduty_incident_custom_field.environment,
+				pagerduty_incident_custom_field.impacted_hosts,
+			]
+		}
+	`, field, name, value))
+}
+
 func createBaseServicePathConfig(ep, s string) string {
 	return fmt.Sprintf(`
 	resource "pagerduty_user" "foo" {
@@ -319,7 +578,7 @@ func createBaseServicePathConfig(ep, s string) string {
 	}
 
 	resource "pagerduty_escalation_policy" "foo" {
-		name        = "%s"
+		name        = "%[1]s"
 		description = "bar"
 		num_loops   = 2
 
@@ -333,7 +592,7 @@ func createBaseServicePathConfig(ep, s string) string {
 	}
 
 	resource "pagerduty_service" "bar" {
-		name = "%s"
+		name = "%[2]s"
 		escalation_policy       = pagerduty_escalation_policy.foo.id
 
 		incident_urgency_rule {
@@ -341,6 +600,34 @@ func createBaseServicePathConfig(ep, s string) string {
 			urgency = "high"
 		}
 	}
+
+	resource "pagerduty_automation_actions_action" "foo" {
+		name = "%[2]s-foo"
+		action_type = "script"
+		action_data_reference {
+			script = "echo foo"
+			invocation_command = "/bin/bash"
+		}
+	}
+
+	resource "pagerduty_automation_actions_action" "bar" {
+		name = "%[2]s-bar"
+		action_type = "script"
+		action_data_reference {
+			script = "echo bar"
+			invocation_command = "/bin/bash"
+		}
+	}
+
+	resource "pagerduty_automation_actions_action_service_association" "foo" {
+		action_id = pagerduty_automation_actions_action.foo.id
+		service_id = pagerduty_service.bar.id
+	}
+
+	resource "pagerduty_automation_actions_action_service_association" "bar" {
+		action_id = pagerduty_automation_actions_action.bar.id
+		service_id = pagerduty_service.bar.id
+	}
 	`, ep, s)
 }
 
@@ -507,6 +794,39 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceAutomationActionsParamsDe
 	`)
 }
 
+func testAccCheckPagerDutyEventOrchestrationPathServicePagerDutyAutomationActionConfig(ep, s, actionID string) string {
+	return fmt.Sprintf("%s%s", createBaseServicePathConfig(ep, s),
+		fmt.Sprintf(`resource "pagerduty_automation_actions_action" "baz" {
+			name = "%s-baz"
+			action_type = "script"
+			action_data_reference {
+				script = "echo baz"
+				invocation_command = "/bin/bash"
+			}
+		}
+
+		resource "pagerduty_event_orchestration_service" "serviceA" {
+			service = pagerduty_service.bar.id
+
+			set {
+				id = "start"
+				rule {
+					label = "rule 1"
+					actions {
+						pagerduty_automation_action {
+							action_id = %s
+						}
+					}
+				}
+			}
+
+			catch_all {
+				actions { }
+			}
+		}
+	`, s, actionID))
+}
+
 func testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(ep, s, re, cae string) string {
 	return fmt.Sprintf(
 		"%s%s",
@@ -532,6 +852,59 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceInvalidExtractionsConfig(
 	)
 }
 
+func testAccCheckPagerDutyEventOrchestrationPathServiceVariablesAndExtractionsConfig(ep, s string) string {
+	return fmt.Sprintf("%s%s", createBaseServicePathConfig(ep, s),
+		`resource "pagerduty_event_orchestration_service" "serviceA" {
+			service = pagerduty_service.bar.id
+
+			set {
+				id = "start"
+				rule {
+					label = "rule 1"
+					actions {
+						variable {
+							name = "hostname"
+							path = "event.source"
+							type = "regex"
+							value = "Source host: (.*)"
+						}
+						variable {
+							name = "cpu_val"
+							path = "event.custom_details.cpu"
+							type = "regex"
+							value = "(.*)"
+						}
+						variable {
+							name = "region"
+							path = "raw_event.region"
+							type = "regex"
+							value = "(.*)"
+						}
+						extraction {
+							target = "event.summary"
+							template = "High CPU usage on {{variables.hostname}}: {{variables.cpu_val}}"
+						}
+						extraction {
+							regex = "host-(.*)"
+							source = "variables.hostname"
+							target = "event.custom_details.message"
+						}
+						extraction {
+							regex = "(.*)"
+							source = "event.custom_details.region"
+							target = "event.group"
+						}
+					}
+				}
+			}
+
+			catch_all {
+				actions { }
+			}
+		}
+	`)
+}
+
 func testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsConfig(ep, s string) string {
 	return fmt.Sprintf("%s%s", createBaseServicePathConfig(ep, s),
 		`resource "pagerduty_event_orchestration_service" "serviceA" {
@@ -553,7 +926,7 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsConfig(ep, s st
 						escalation_policy = pagerduty_escalation_policy.foo.id
 						annotate = "Routed through an event orchestration"
 						pagerduty_automation_action {
-							action_id = "01CSB5SMOKCKVRI5GN0LJG7SMB"
+							action_id = pagerduty_automation_actions_action_service_association.foo.action_id
 						}
 						severity = "critical"
 						event_action = "trigger"
@@ -611,7 +984,7 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsConfig(ep, s st
 					escalation_policy = pagerduty_escalation_policy.foo.id
 					annotate = "Routed through an event orchestration - catch-all rule"
 					pagerduty_automation_action {
-						action_id = "01CSB5SMOKCKVRI5GN0LJG7SMC"
+						action_id = pagerduty_automation_actions_action_service_association.foo.action_id
 					}
 					severity = "warning"
 					event_action = "trigger"
@@ -660,7 +1033,7 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsUpdateConfig(ep
 						escalation_policy = "POLICY3"
 						annotate = "Routed through a service orchestration!"
 						pagerduty_automation_action {
-							action_id = "01CSB5SMOKCKVRI5GN0LJG7SMBUPDATED"
+							action_id = pagerduty_automation_actions_action_service_association.bar.action_id
 						}
 						severity = "warning"
 						event_action = "resolve"
@@ -732,7 +1105,7 @@ func testAccCheckPagerDutyEventOrchestrationPathServiceAllActionsUpdateConfig(ep
 					escalation_policy = "POLICY4"
 					annotate = "[UPD] Routed through an event orchestration - catch-all rule"
 					pagerduty_automation_action {
-						action_id = "01CSB5SMOKCKVRI5GN0LJG7SMD"
+						action_id = pagerduty_automation_actions_action_service_association.bar.action_id
 					}
 					severity = "info"
 					event_action = "resolve"
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 func resourcePagerDutyEventOrchestrationPathUnrouted() *schema.Resource {
@@ -22,7 +23,7 @@ func resourcePagerDutyEventOrchestrationPathUnrouted() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourcePagerDutyEventOrchestrationPathUnroutedImport,
 		},
-		CustomizeDiff: checkExtractions,
+		CustomizeDiff: customizeDiffAll(checkExtractions, checkEventOrchestrationPathConditions),
 		Schema: map[string]*schema.Schema{
 			"event_orchestration": {
 				Type:     schema.TypeString,
@@ -78,20 +79,8 @@ func resourcePagerDutyEventOrchestrationPathUnrouted() *schema.Resource {
 													Optional:         true,
 													ValidateDiagFunc: validateEventOrchestrationPathEventAction(),
 												},
-												"variable": {
-													Type:     schema.TypeList,
-													Optional: true,
-													Elem: &schema.Resource{
-														Schema: eventOrchestrationPathVariablesSchema,
-													},
-												},
-												"extraction": {
-													Type:     schema.TypeList,
-													Optional: true,
-													Elem: &schema.Resource{
-														Schema: eventOrchestrationPathExtractionsSchema,
-													},
-												},
+												"variable":   eventOrchestrationPathVariableActionSchema,
+												"extraction": eventOrchestrationPathExtractionActionSchema,
 											},
 										},
 									},
@@ -139,20 +128,8 @@ func resourcePagerDutyEventOrchestrationPathUnrouted() *schema.Resource {
 											"resolve",
 										}),
 									},
-									"variable": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem: &schema.Resource{
-											Schema: eventOrchestrationPathVariablesSchema,
-										},
-									},
-									"extraction": {
-										Type:     schema.TypeList,
-										Optional: true,
-										Elem: &schema.Resource{
-											Schema: eventOrchestrationPathExtractionsSchema,
-										},
-									},
+									"variable":   eventOrchestrationPathVariableActionSchema,
+									"extraction": eventOrchestrationPathExtractionActionSchema,
 								},
 							},
 						},
@@ -179,7 +156,7 @@ func resourcePagerDutyEventOrchestrationPathUnroutedRead(ctx context.Context, d
 				return retry.NonRetryableError(err)
 			}
 
-			time.Sleep(2 * time.Second)
+			util.SleepContext(ctx, 2*time.Second)
 			return retry.RetryableError(err)
 		} else if unroutedPath != nil {
 			if unroutedPath.Sets != nil {
@@ -281,7 +258,7 @@ func resourcePagerDutyEventOrchestrationPathUnroutedUpdate(ctx context.Context,
 	})
 
 	if retryErr != nil {
-		time.Sleep(2 * time.Second)
+		util.SleepContext(ctx, 2*time.Second)
 		return diag.FromErr(retryErr)
 	}
 
@@ -355,8 +332,7 @@ func expandUnroutedActions(v interface{}) *pagerduty.EventOrchestrationPathRuleA
 			actions.RouteTo = am["route_to"].(string)
 			actions.Severity = am["severity"].(string)
 			actions.EventAction = am["event_action"].(string)
-			actions.Variables = expandEventOrchestrationPathVariables(am["variable"])
-			actions.Extractions = expandEventOrchestrationPathExtractions(am["extraction"])
+			expandEventOrchestrationPathVariablesAndExtractions(am, actions)
 		}
 	}
 
@@ -383,8 +359,7 @@ func expandUnroutedCatchAllActions(v interface{}) *pagerduty.EventOrchestrationP
 			am := ai.(map[string]interface{})
 			actions.Severity = am["severity"].(string)
 			actions.EventAction = am["event_action"].(string)
-			actions.Variables = expandEventOrchestrationPathVariables(am["variable"])
-			actions.Extractions = expandEventOrchestrationPathExtractions(am["extraction"])
+			expandEventOrchestrationPathVariablesAndExtractions(am, actions)
 		}
 	}
 
@@ -430,12 +405,7 @@ func flattenUnroutedActions(actions *pagerduty.EventOrchestrationPathRuleActions
 		"event_action": actions.EventAction,
 	}
 
-	if actions.Variables != nil {
-		flattenedAction["variable"] = flattenEventOrchestrationPathVariables(actions.Variables)
-	}
-	if actions.Extractions != nil {
-		flattenedAction["extraction"] = flattenEventOrchestrationPathExtractions(actions.Extractions)
-	}
+	flattenEventOrchestrationPathVariablesAndExtractions(actions, flattenedAction)
 
 	actionsMap = append(actionsMap, flattenedAction)
 
@@ -462,12 +432,7 @@ func flattenUnroutedCatchAllActions(actions *pagerduty.EventOrchestrationPathRul
 		"suppress":     actions.Suppress, // By default suppress is set to "true" by API for unrouted
 	}
 
-	if actions.Variables != nil {
-		flattenedAction["variable"] = flattenEventOrchestrationPathVariables(actions.Variables)
-	}
-	if actions.Variables != nil {
-		flattenedAction["extraction"] = flattenEventOrchestrationPathExtractions(actions.Extractions)
-	}
+	flattenEventOrchestrationPathVariablesAndExtractions(actions, flattenedAction)
 
 	actionsMap = append(actionsMap, flattenedAction)
 
@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -49,16 +50,56 @@ func TestAccPagerDutyEventOrchestrationServiceCacheVariable_Basic(t *testing.T)
 	disabled1 := "false"
 	disabled2 := "true"
 
+	invalidConfigs := []struct {
+		config string
+		err    string
+	}{
+		{`
+		configuration {
+  		type = "recent_value"
+  		regex = ".*"
+    }
+  `, "regex and source cannot be null when type is recent_value"},
+		{`
+		configuration {
+  		type = "trigger_event_count"
+    }
+  `, "ttl_seconds cannot be null when type is trigger_event_count"},
+		{`
+		configuration {
+  		type = "recent_value"
+  		source = "event.custom_details"
+  		regex = "[a-z"
+    }
+  `, `regex "\[a-z" is not a valid regular expression`},
+		{`
+		configuration {
+  		type = "last_value"
+  		ttl_seconds = 60
+    }
+  `, `"last_value" is an invalid value`},
+	}
+	steps := []resource.TestStep{}
+	for _, c := range invalidConfigs {
+		steps = append(steps, resource.TestStep{
+			Config:      testAccCheckPagerDutyEventOrchestrationServiceCacheVariableConfig(svc, name1, svcn1, disabled1, c.config, cond1),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(c.err),
+		})
+	}
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckPagerDutyEventOrchestrationServiceCacheVariableDestroy,
-		Steps: []resource.TestStep{
+		Steps: append(steps, []resource.TestStep{
 			{
 				Config: testAccCheckPagerDutyEventOrchestrationServiceCacheVariableConfig(svc, name1, svcn1, disabled1, config1, cond1),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPagerDutyEventOrchestrationServiceCacheVariableID(cv, svcn1),
 					resource.TestCheckResourceAttr(cv, "name", name1),
+					resource.TestCheckResourceAttr(cv, "configuration.0.type", "trigger_event_count"),
+					resource.TestCheckResourceAttr(cv, "configuration.0.ttl_seconds", "60"),
 				),
 			},
 			// update name and disabled state:
@@ -102,7 +143,7 @@ func TestAccPagerDutyEventOrchestrationServiceCacheVariable_Basic(t *testing.T)
 					testAccCheckPagerDutyEventOrchestrationServiceCacheVariableExistsNot(cv),
 				),
 			},
-		},
+		}...),
 	})
 }
 
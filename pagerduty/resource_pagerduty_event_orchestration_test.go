@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -162,6 +163,63 @@ func testAccCheckPagerDutyEventOrchestrationExists(rn string) resource.TestCheck
 	}
 }
 
+func TestAccPagerDutyEventOrchestration_TeamChangeInPlace(t *testing.T) {
+	name := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+	description := fmt.Sprintf("tf-orchestration-description-%s", acctest.RandString(5))
+	team1 := fmt.Sprintf("tf-team-%s", acctest.RandString(5))
+	team2 := fmt.Sprintf("tf-team-%s", acctest.RandString(5))
+	var id, routingKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationConfig(name, description, team1, team2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationExists("pagerduty_event_orchestration.foo"),
+					testAccCheckPagerDutyEventOrchestrationTeamMatch("pagerduty_event_orchestration.foo", "pagerduty_team.foo"),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources["pagerduty_event_orchestration.foo"].Primary.Attributes
+						id, routingKey = attrs["id"], attrs["integration.0.parameters.0.routing_key"]
+						if routingKey == "" {
+							return fmt.Errorf("Event Orchestration %s has no routing key", id)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationConfigUpdated(name, description, team1, team2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pagerduty_event_orchestration.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationTeamMatch("pagerduty_event_orchestration.foo", "pagerduty_team.bar"),
+					resource.TestCheckResourceAttrPtr("pagerduty_event_orchestration.foo", "id", &id),
+					resource.TestCheckResourceAttrPtr("pagerduty_event_orchestration.foo", "integration.0.parameters.0.routing_key", &routingKey),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationConfigDescriptionTeamDeleted(name, team1, team2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pagerduty_event_orchestration.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_event_orchestration.foo", "team", ""),
+					resource.TestCheckResourceAttrPtr("pagerduty_event_orchestration.foo", "id", &id),
+					resource.TestCheckResourceAttrPtr("pagerduty_event_orchestration.foo", "integration.0.parameters.0.routing_key", &routingKey),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyEventOrchestrationTeamMatch(orchName, teamName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		o, orchOk := s.RootModule().Resources[orchName]
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 func resourcePagerDutyIncidentCustomField() *schema.Resource {
@@ -140,7 +141,7 @@ func fetchField(ctx context.Context, d *schema.ResourceData, meta interface{}, e
 
 			errResp := errorCallback(err, d)
 			if errResp != nil {
-				time.Sleep(2 * time.Second)
+				util.SleepContext(ctx, 2*time.Second)
 				return retry.RetryableError(errResp)
 			}
 
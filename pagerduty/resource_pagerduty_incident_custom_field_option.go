@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 func resourcePagerDutyIncidentCustomFieldOption() *schema.Resource {
@@ -19,6 +21,9 @@ func resourcePagerDutyIncidentCustomFieldOption() *schema.Resource {
 		UpdateContext: resourcePagerDutyIncidentCustomFieldOptionUpdate,
 		DeleteContext: resourcePagerDutyIncidentCustomFieldOptionDelete,
 		CreateContext: resourcePagerDutyIncidentCustomFieldOptionCreate,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePagerDutyIncidentCustomFieldOptionImport,
+		},
 		// this function does not actually customize the diff but uses this hook
 		// to validate the combination of datatype and value.
 		CustomizeDiff: validateIncidentCustomFieldOptionValue,
@@ -28,9 +33,12 @@ func resourcePagerDutyIncidentCustomFieldOption() *schema.Resource {
 				Required: true,
 			},
 			"data_type": {
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: validateValueDiagFunc([]string{pagerduty.IncidentCustomFieldDataTypeString.String()}),
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: validateValueDiagFunc([]string{
+					pagerduty.IncidentCustomFieldDataTypeString.String(),
+					pagerduty.IncidentCustomFieldDataTypeInt.String(),
+				}),
 			},
 			"value": {
 				Type:     schema.TypeString,
@@ -40,7 +48,7 @@ func resourcePagerDutyIncidentCustomFieldOption() *schema.Resource {
 	}
 }
 
-func validateIncidentCustomFieldOptionValue(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+func validateIncidentCustomFieldOptionValue(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	datatype := pagerduty.IncidentCustomFieldDataTypeFromString(diff.Get("data_type").(string))
 	value := diff.Get("value").(string)
 
@@ -48,7 +56,46 @@ func validateIncidentCustomFieldOptionValue(_ context.Context, diff *schema.Reso
 		return fmt.Errorf("invalid value for data_type %v: %v", datatype, value)
 	}
 
-	return validateIncidentCustomFieldValue(value, datatype, false, generateError)
+	if err := validateIncidentCustomFieldValue(value, datatype, false, generateError); err != nil {
+		return err
+	}
+
+	// The field isn't known yet when it's created in the same apply.
+	fieldID := diff.Get("field").(string)
+	if !diff.NewValueKnown("field") || fieldID == "" || !diff.HasChanges("field", "data_type") {
+		return nil
+	}
+
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+
+	field, _, err := client.IncidentCustomFields.GetContext(ctx, fieldID, nil)
+	if err != nil {
+		return err
+	}
+
+	if field.DataType != datatype {
+		return fmt.Errorf("data_type %v doesn't match the data_type %v of the field %s", datatype, field.DataType, fieldID)
+	}
+
+	return nil
+}
+
+func resourcePagerDutyIncidentCustomFieldOptionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ids := strings.Split(d.Id(), ":")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_incident_custom_field_option. Expecting an ID formed as '<field_id>:<field_option_id>'")
+	}
+	fieldID, id := ids[0], ids[1]
+
+	d.SetId(id)
+	if err := fetchFieldOption(ctx, fieldID, d, meta, func(err error, _ *schema.ResourceData) error { return err }); err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourcePagerDutyIncidentCustomFieldOptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -173,7 +220,7 @@ func fetchFieldOption(ctx context.Context, fieldID string, d *schema.ResourceDat
 					return retry.NonRetryableError(err)
 				}
 
-				time.Sleep(2 * time.Second)
+				util.SleepContext(ctx, 2*time.Second)
 				return retry.RetryableError(errResp)
 			}
 
@@ -22,13 +22,53 @@ func TestAccPagerDutyIncidentCustomFieldOptions_Basic(t *testing.T) {
 	testAccExecuteIncidentCustomFieldOptionTest(t, fieldName, dataType, fieldOptionValue, fieldOptionValue2)
 }
 
+func TestAccPagerDutyIncidentCustomFieldOptions_Integer(t *testing.T) {
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+	dataType := pagerduty.IncidentCustomFieldDataTypeInt
+
+	testAccExecuteIncidentCustomFieldOptionTest(t, fieldName, dataType, "1000000", "42")
+}
+
 func TestAccPagerDutyIncidentCustomFieldOptions_InvalidDataType(t *testing.T) {
 	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
 	fieldOptionValue := fmt.Sprintf("tf_%s", acctest.RandString(5))
-	dataType := pagerduty.IncidentCustomFieldDataTypeInt
+	dataType := pagerduty.IncidentCustomFieldDataTypeFloat
 
 	testAccExecuteIncidentCustomFieldOptionTestError(t, fieldName, dataType, fieldOptionValue,
-		regexp.MustCompile(`Error: "integer" is an invalid value. Must be one of \[]string{"string"}`))
+		regexp.MustCompile(`Error: "float" is an invalid value. Must be one of \[]string{"string", "integer"}`))
+}
+
+func TestAccPagerDutyIncidentCustomFieldOptions_InvalidIntegerValue(t *testing.T) {
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+	dataType := pagerduty.IncidentCustomFieldDataTypeInt
+
+	testAccExecuteIncidentCustomFieldOptionTestError(t, fieldName, dataType, "4.2",
+		regexp.MustCompile(`invalid value for data_type integer: 4.2`))
+}
+
+func TestAccPagerDutyIncidentCustomFieldOptions_MismatchedDataType(t *testing.T) {
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+	var fieldID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentCustomFieldTests(t)
+
+			field := testAccCreateTestPagerDutyIncidentCustomFieldForFieldOption(fieldName, pagerduty.IncidentCustomFieldDataTypeString)
+			fieldID = field.ID
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccDeleteTestPagerDutyIncidentCustomFieldForFieldOption(fieldID)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyIncidentCustomFieldOptionConfig(fieldName, pagerduty.IncidentCustomFieldDataTypeInt, "42"),
+				ExpectError: regexp.MustCompile(`data_type integer doesn't match the data_type string of the field`),
+			},
+		},
+	})
 }
 
 func testAccExecuteIncidentCustomFieldOptionTest(t *testing.T, fieldName string, dataType pagerduty.IncidentCustomFieldDataType, fieldOptionValue, fieldOptionValueForUpdate string) {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 // This integer controls the level of inline_steps_inputs recursion allowed in the Incident Workflow schema.
@@ -52,7 +53,7 @@ func resourcePagerDutyIncidentWorkflow() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourcePagerDutyIncidentWorkflowImport,
 		},
-		CustomizeDiff: customizeIncidentWorkflowDiff(),
+		CustomizeDiff: customizeDiffAll(customizeIncidentWorkflowDiff(), checkIncidentWorkflowStepInputs),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -67,6 +68,11 @@ func resourcePagerDutyIncidentWorkflow() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"validate_inputs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"step": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -356,7 +362,7 @@ func resourcePagerDutyIncidentWorkflowCreate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	iw, specifiedSteps, err := buildIncidentWorkflowStruct(d)
+	iw, specifiedSteps, err := buildIncidentWorkflowStruct(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -368,7 +374,7 @@ func resourcePagerDutyIncidentWorkflowCreate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	err = flattenIncidentWorkflow(d, createdWorkflow, true, specifiedSteps, false)
+	err = flattenIncidentWorkflow(d, createdWorkflow, true, specifiedSteps, false, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -390,7 +396,7 @@ func resourcePagerDutyIncidentWorkflowUpdate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	iw, specifiedSteps, err := buildIncidentWorkflowStruct(d)
+	iw, specifiedSteps, err := buildIncidentWorkflowStruct(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -402,7 +408,7 @@ func resourcePagerDutyIncidentWorkflowUpdate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	err = flattenIncidentWorkflow(d, updatedWorkflow, true, specifiedSteps, false)
+	err = flattenIncidentWorkflow(d, updatedWorkflow, true, specifiedSteps, false, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -424,6 +430,7 @@ func resourcePagerDutyIncidentWorkflowDelete(ctx context.Context, d *schema.Reso
 
 func resourcePagerDutyIncidentWorkflowImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	err := fetchIncidentWorkflow(ctx, d, m, handleNotFoundError, true)
+	d.Set("validate_inputs", false)
 	return []*schema.ResourceData{d}, err
 }
 
@@ -433,7 +440,7 @@ func fetchIncidentWorkflow(ctx context.Context, d *schema.ResourceData, meta int
 		return err
 	}
 
-	_, specifiedSteps, err := buildIncidentWorkflowStruct(d)
+	_, specifiedSteps, err := buildIncidentWorkflowStruct(d, meta)
 	if err != nil {
 		return err
 	}
@@ -448,14 +455,14 @@ func fetchIncidentWorkflow(ctx context.Context, d *schema.ResourceData, meta int
 
 			errResp := errorCallback(err, d)
 			if errResp != nil {
-				time.Sleep(2 * time.Second)
+				util.SleepContext(ctx, 2*time.Second)
 				return retry.RetryableError(errResp)
 			}
 
 			return nil
 		}
 
-		if err := flattenIncidentWorkflow(d, iw, true, specifiedSteps, isImport); err != nil {
+		if err := flattenIncidentWorkflow(d, iw, true, specifiedSteps, isImport, meta); err != nil {
 			return retry.NonRetryableError(err)
 		}
 		return nil
@@ -468,13 +475,14 @@ func flattenIncidentWorkflow(
 	includeSteps bool,
 	specifiedSteps []*SpecifiedStep,
 	isImport bool,
+	meta interface{},
 ) error {
 	d.SetId(iw.ID)
 	d.Set("name", iw.Name)
 	if iw.Description != nil {
 		d.Set("description", *(iw.Description))
 	}
-	if iw.Team != nil {
+	if iw.Team != nil && !isUnconfiguredDefaultTeam(d, "team", iw.Team.ID, meta) {
 		d.Set("team", iw.Team.ID)
 	}
 
@@ -601,7 +609,7 @@ type SpecifiedStep struct {
 	SpecifiedInlineInputs map[string][]*SpecifiedStep
 }
 
-func buildIncidentWorkflowStruct(d *schema.ResourceData) (
+func buildIncidentWorkflowStruct(d *schema.ResourceData, meta interface{}) (
 	*pagerduty.IncidentWorkflow,
 	[]*SpecifiedStep,
 	error,
@@ -613,9 +621,9 @@ func buildIncidentWorkflowStruct(d *schema.ResourceData) (
 		str := desc.(string)
 		iw.Description = &str
 	}
-	if team, ok := d.GetOk("team"); ok {
+	if team := applyDefaultTeamID(d.Get("team").(string), meta); team != "" {
 		iw.Team = &pagerduty.TeamReference{
-			ID: team.(string),
+			ID: team,
 		}
 	}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 func resourcePagerDutyIncidentWorkflowTrigger() *schema.Resource {
@@ -22,7 +23,7 @@ func resourcePagerDutyIncidentWorkflowTrigger() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: validateIncidentWorkflowTrigger,
+		CustomizeDiff: customizeDiffAll(validateIncidentWorkflowTrigger, checkIncidentWorkflowTriggerCondition),
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Type:     schema.TypeString,
@@ -192,7 +193,7 @@ func fetchIncidentWorkflowTrigger(ctx context.Context, d *schema.ResourceData, m
 
 			errResp := errorCallback(err, d)
 			if errResp != nil {
-				time.Sleep(2 * time.Second)
+				util.SleepContext(ctx, 2*time.Second)
 				return retry.RetryableError(errResp)
 			}
 
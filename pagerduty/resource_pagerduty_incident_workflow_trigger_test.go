@@ -125,6 +125,30 @@ resource "pagerduty_incident_workflow_trigger" "my_first_workflow_trigger" {
 	})
 }
 
+func TestAccPagerDutyIncidentWorkflowTrigger_ConditionalTypeWithInvalidCondition(t *testing.T) {
+	config := `
+resource "pagerduty_incident_workflow_trigger" "my_first_workflow_trigger" {
+  type             = "conditional"
+  workflow         = "ignored"
+  condition        = "(incident.priority matches 'P1' and"
+  subscribed_to_all_services = true
+}
+`
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentWorkflows(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`Invalid condition of incident workflow trigger for workflow "ignored"`),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyIncidentWorkflowTrigger_SubscribedToAllWithInvalidServices(t *testing.T) {
 	config := `
 resource "pagerduty_incident_workflow_trigger" "my_first_workflow_trigger" {
@@ -1,12 +1,15 @@
 package pagerduty
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
@@ -19,18 +22,28 @@ func resourcePagerDutyMaintenanceWindow() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeMaintenanceWindowDiff,
 		Schema: map[string]*schema.Schema{
 			"start_time": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
 				ValidateFunc:     validateRFC3339,
 				DiffSuppressFunc: suppressRFC3339Diff,
 			},
 			"end_time": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				Computed:         true,
 				ValidateFunc:     validateRFC3339,
 				DiffSuppressFunc: suppressRFC3339Diff,
+				ConflictsWith:    []string{"duration"},
+			},
+			"duration": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validateMaintenanceWindowDuration,
+				ConflictsWith: []string{"end_time"},
 			},
 
 			"services": {
@@ -45,22 +58,85 @@ func resourcePagerDutyMaintenanceWindow() *schema.Resource {
 				Optional: true,
 				Default:  "Managed by Terraform",
 			},
+
+			"from_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
-func buildMaintenanceWindowStruct(d *schema.ResourceData) *pagerduty.MaintenanceWindow {
+func validateMaintenanceWindowDuration(v interface{}, k string) (ws []string, es []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		es = append(es, fmt.Errorf("%s must be a duration like \"90m\" or \"2h\", got: %q", k, v.(string)))
+		return
+	}
+	if duration <= 0 {
+		es = append(es, fmt.Errorf("%s must be a positive duration, got: %q", k, v.(string)))
+	}
+	return
+}
+
+func customizeMaintenanceWindowDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() {
+		return nil
+	}
+
+	if config.GetAttr("duration").IsNull() {
+		if config.GetAttr("start_time").IsNull() || config.GetAttr("end_time").IsNull() {
+			return fmt.Errorf("start_time and end_time must be set when duration isn't")
+		}
+		return nil
+	}
+
+	// The end of the window is calculated from its duration at apply time, so
+	// it changes whenever the duration or the start of the window do.
+	if diff.Id() != "" && (diff.HasChange("duration") || diff.HasChange("start_time")) {
+		return diff.SetNewComputed("end_time")
+	}
+
+	return nil
+}
+
+func buildMaintenanceWindowStruct(d *schema.ResourceData) (*pagerduty.MaintenanceWindow, error) {
 	window := &pagerduty.MaintenanceWindow{
 		StartTime: d.Get("start_time").(string),
 		EndTime:   d.Get("end_time").(string),
 		Services:  expandServices(d.Get("services").(*schema.Set)),
 	}
 
+	if v, ok := d.GetOk("duration"); ok {
+		duration, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		// Without a start time the window starts right away.
+		start := time.Now().UTC()
+		if window.StartTime != "" {
+			if start, err = timeToUTC(window.StartTime); err != nil {
+				return nil, err
+			}
+		}
+
+		window.StartTime = start.Format(time.RFC3339)
+		window.EndTime = start.Add(duration).Format(time.RFC3339)
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		window.Description = v.(string)
 	}
 
-	return window
+	return window, nil
 }
 
 func resourcePagerDutyMaintenanceWindowCreate(d *schema.ResourceData, meta interface{}) error {
@@ -69,20 +145,53 @@ func resourcePagerDutyMaintenanceWindowCreate(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	window := buildMaintenanceWindowStruct(d)
+	window, err := buildMaintenanceWindowStruct(d)
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Creating PagerDuty maintenance window")
 
-	window, _, err = client.MaintenanceWindows.Create(window)
+	if from, ok := d.GetOk("from_email"); ok {
+		window, err = createMaintenanceWindowFrom(context.Background(), client, window, from.(string))
+	} else {
+		window, _, err = client.MaintenanceWindows.Create(window)
+	}
 	if err != nil {
 		return err
 	}
 
 	d.SetId(window.ID)
+	d.Set("start_time", window.StartTime)
+	d.Set("end_time", window.EndTime)
+	d.Set("created_by", flattenMaintenanceWindowCreatedBy(window.CreatedBy))
 
 	return nil
 }
 
+// createMaintenanceWindowFrom creates the maintenance window acting as the
+// user with the email from, so the window is attributed to that user instead
+// of the owner of the API token. The API client can't set the From header of
+// its requests for maintenance windows.
+func createMaintenanceWindowFrom(ctx context.Context, client *pagerduty.Client, window *pagerduty.MaintenanceWindow, from string) (*pagerduty.MaintenanceWindow, error) {
+	header := http.Header{}
+	header.Set("From", from)
+
+	v := new(pagerduty.MaintenanceWindowPayload)
+	if err := postAPIResource(ctx, client, "/maintenance_windows", header, &pagerduty.MaintenanceWindowPayload{MaintenanceWindow: window}, v); err != nil {
+		return nil, err
+	}
+
+	return v.MaintenanceWindow, nil
+}
+
+func flattenMaintenanceWindowCreatedBy(v *pagerduty.UserReference) string {
+	if v == nil {
+		return ""
+	}
+	return v.ID
+}
+
 func resourcePagerDutyMaintenanceWindowRead(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -110,6 +219,7 @@ func resourcePagerDutyMaintenanceWindowRead(d *schema.ResourceData, meta interfa
 		d.Set("description", window.Description)
 		d.Set("start_time", window.StartTime)
 		d.Set("end_time", window.EndTime)
+		d.Set("created_by", flattenMaintenanceWindowCreatedBy(window.CreatedBy))
 
 		if err := d.Set("services", flattenServices(window.Services)); err != nil {
 			return retry.NonRetryableError(err)
@@ -125,13 +235,26 @@ func resourcePagerDutyMaintenanceWindowUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	window := buildMaintenanceWindowStruct(d)
+	window, err := buildMaintenanceWindowStruct(d)
+	if err != nil {
+		return err
+	}
+
+	// When only the description changes, it's the only field sent, so the
+	// windows already in progress, whose start can't be moved anymore, are
+	// updated in place too.
+	if !d.HasChanges("start_time", "end_time", "duration", "services") {
+		window = &pagerduty.MaintenanceWindow{Description: window.Description}
+	}
 
 	log.Printf("[INFO] Updating PagerDuty maintenance window %s", d.Id())
 
-	if _, _, err := client.MaintenanceWindows.Update(d.Id(), window); err != nil {
+	updated, _, err := client.MaintenanceWindows.Update(d.Id(), window)
+	if err != nil {
 		return err
 	}
+	d.Set("start_time", updated.StartTime)
+	d.Set("end_time", updated.EndTime)
 
 	return nil
 }
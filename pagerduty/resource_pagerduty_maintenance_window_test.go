@@ -1,8 +1,12 @@
 package pagerduty
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -58,6 +62,8 @@ func TestAccPagerDutyMaintenanceWindow_Basic(t *testing.T) {
 				Config: testAccCheckPagerDutyMaintenanceWindowConfig(window, windowStartTime, windowEndTime),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_maintenance_window.foo", "created_by"),
 				),
 			},
 			{
@@ -70,6 +76,202 @@ func TestAccPagerDutyMaintenanceWindow_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyMaintenanceWindow_Duration(t *testing.T) {
+	window := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	windowEndTime := timeNowInAccLoc().Add(48 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyMaintenanceWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyMaintenanceWindowConfigDuration(window, "2h", fmt.Sprintf("end_time = %q", windowEndTime)),
+				ExpectError: regexp.MustCompile("conflicts with"),
+			},
+			{
+				Config:      testAccCheckPagerDutyMaintenanceWindowConfigDuration(window, "two hours", ""),
+				ExpectError: regexp.MustCompile("must be a duration"),
+			},
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfigDuration(window, "2h", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					testAccCheckPagerDutyMaintenanceWindowLength("pagerduty_maintenance_window.foo", 2*time.Hour),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyMaintenanceWindowConfigDuration(window, "2h", ""),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfigDuration(window, "3h", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					testAccCheckPagerDutyMaintenanceWindowLength("pagerduty_maintenance_window.foo", 3*time.Hour),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyMaintenanceWindow_FromEmail(t *testing.T) {
+	window := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyMaintenanceWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfigDuration(window, "2h", "from_email = pagerduty_user.foo.email"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_maintenance_window.foo", "created_by", "pagerduty_user.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyMaintenanceWindow_DescriptionInPlace(t *testing.T) {
+	window := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	var windowID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyMaintenanceWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Without a start time the window is already in progress.
+				Config: testAccCheckPagerDutyMaintenanceWindowConfigDescription(window, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					testAccCheckPagerDutyMaintenanceWindowID("pagerduty_maintenance_window.foo", &windowID),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfigDescription(window, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					testAccCheckPagerDutyMaintenanceWindowID("pagerduty_maintenance_window.foo", &windowID),
+					resource.TestCheckResourceAttr(
+						"pagerduty_maintenance_window.foo", "description", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourcePagerDutyMaintenanceWindowUpdate_Description(t *testing.T) {
+	var body map[string]map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPut || r.URL.Path != "/maintenance_windows/PMW1234" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(`{"maintenance_window":{"id":"PMW1234","description":"bar","start_time":"2030-01-01T00:00:00Z","end_time":"2030-01-01T02:00:00Z"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := resourcePagerDutyMaintenanceWindow()
+	d := r.TestResourceData()
+	d.SetId("PMW1234")
+	d.Set("description", "foo")
+	d.Set("start_time", "2030-01-01T00:00:00Z")
+	d.Set("end_time", "2030-01-01T02:00:00Z")
+	d.Set("services", []interface{}{"PSVC123"})
+	d = r.Data(d.State())
+	d.Set("description", "bar")
+
+	if err := resourcePagerDutyMaintenanceWindowUpdate(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if window := body["maintenance_window"]; len(window) != 1 || window["description"] != "bar" {
+		t.Errorf("expected only the description of the window to be sent, got %v", window)
+	}
+	if d.Id() != "PMW1234" || d.Get("start_time") != "2030-01-01T00:00:00Z" || d.Get("end_time") != "2030-01-01T02:00:00Z" {
+		t.Errorf("expected the window to be kept, got %s from %v to %v", d.Id(), d.Get("start_time"), d.Get("end_time"))
+	}
+}
+
+func TestResourcePagerDutyMaintenanceWindowCreate_FromEmail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPost || r.URL.Path != "/maintenance_windows" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got := r.Header.Get("From"); got != "jane@foo.test" {
+			t.Errorf("expected the window to be created acting as jane@foo.test, got a From header of %q", got)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"maintenance_window":{"id":"PMW1234","start_time":"2030-01-01T00:00:00Z","end_time":"2030-01-01T02:00:00Z","created_by":{"id":"PJANE12","type":"user_reference"}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := resourcePagerDutyMaintenanceWindow()
+	d := r.TestResourceData()
+	d.Set("start_time", "2030-01-01T00:00:00Z")
+	d.Set("end_time", "2030-01-01T02:00:00Z")
+	d.Set("services", []interface{}{"PSVC123"})
+	d.Set("from_email", "jane@foo.test")
+
+	if err := resourcePagerDutyMaintenanceWindowCreate(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Id() != "PMW1234" {
+		t.Errorf("expected the ID of the window to be set, got %q", d.Id())
+	}
+	if got := d.Get("created_by").(string); got != "PJANE12" {
+		t.Errorf("expected created_by to be PJANE12, got %q", got)
+	}
+}
+
+func testAccCheckPagerDutyMaintenanceWindowLength(n string, expected time.Duration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		start, err := time.Parse(time.RFC3339, rs.Primary.Attributes["start_time"])
+		if err != nil {
+			return err
+		}
+		end, err := time.Parse(time.RFC3339, rs.Primary.Attributes["end_time"])
+		if err != nil {
+			return err
+		}
+
+		if length := end.Sub(start); length != expected {
+			return fmt.Errorf("Expected maintenance window to last %s, got: %s", expected, length)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckPagerDutyMaintenanceWindowDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -159,6 +361,64 @@ resource "pagerduty_maintenance_window" "foo" {
 `, desc, start, end)
 }
 
+func testAccCheckPagerDutyMaintenanceWindowConfigDuration(desc, duration, extra string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%[1]v"
+  email       = "%[1]v@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%[1]v"
+  num_loops   = 2
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[1]v"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_maintenance_window" "foo" {
+  description = "%[1]v"
+  duration    = "%[2]v"
+  services    = [pagerduty_service.foo.id]
+  %[3]v
+}
+`, desc, duration, extra)
+}
+
+func testAccCheckPagerDutyMaintenanceWindowID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if *id == "" {
+			*id = rs.Primary.ID
+		}
+		if rs.Primary.ID != *id {
+			return fmt.Errorf("Expected the maintenance window %s to be kept, got %s", *id, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyMaintenanceWindowConfigDescription(name, description string) string {
+	return strings.Replace(
+		testAccCheckPagerDutyMaintenanceWindowConfigDuration(name, "2h", ""),
+		fmt.Sprintf("description = %q\n  duration", name),
+		fmt.Sprintf("description = %q\n  duration", description), 1)
+}
+
 func testAccCheckPagerDutyMaintenanceWindowConfigUpdated(desc, start, end string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
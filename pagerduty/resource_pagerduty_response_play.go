@@ -180,7 +180,7 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 	}
 }
 
-func buildResponsePlayStruct(d *schema.ResourceData) *pagerduty.ResponsePlay {
+func buildResponsePlayStruct(d *schema.ResourceData, meta interface{}) *pagerduty.ResponsePlay {
 	responsePlay := &pagerduty.ResponsePlay{
 		Name:      d.Get("name").(string),
 		FromEmail: d.Get("from").(string),
@@ -191,9 +191,9 @@ func buildResponsePlayStruct(d *schema.ResourceData) *pagerduty.ResponsePlay {
 	if attr, ok := d.GetOk("description"); ok {
 		responsePlay.Description = attr.(string)
 	}
-	if attr, ok := d.GetOk("team"); ok {
+	if team := applyDefaultTeamID(d.Get("team").(string), meta); team != "" {
 		responsePlay.Team = &pagerduty.TeamReference{
-			ID:   attr.(string),
+			ID:   team,
 			Type: "team",
 		}
 	}
@@ -233,7 +233,7 @@ func resourcePagerDutyResponsePlayCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	responsePlay := buildResponsePlayStruct(d)
+	responsePlay := buildResponsePlayStruct(d, meta)
 
 	log.Printf("[INFO] Creating PagerDuty response play: %s", responsePlay.ID)
 
@@ -276,8 +276,8 @@ func resourcePagerDutyResponsePlayRead(d *schema.ResourceData, meta interface{})
 			time.Sleep(2 * time.Second)
 			return retry.RetryableError(err)
 		} else if responsePlay != nil {
-			if responsePlay.Team != nil {
-				d.Set("team", []interface{}{responsePlay.Team})
+			if responsePlay.Team != nil && !isUnconfiguredDefaultTeam(d, "team", responsePlay.Team.ID, meta) {
+				d.Set("team", responsePlay.Team.ID)
 			}
 			log.Printf("[INFO] Read PagerDuty response play initial subscribers: %s", d.Get("subscriber"))
 			if err := d.Set("subscriber", flattenSubscribers(responsePlay.Subscribers)); err != nil {
@@ -308,7 +308,7 @@ func resourcePagerDutyResponsePlayUpdate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	responsePlay := buildResponsePlayStruct(d)
+	responsePlay := buildResponsePlayStruct(d, meta)
 
 	log.Printf("[INFO] Updating PagerDuty response play: %s", d.Id())
 
@@ -39,8 +39,9 @@ func resourcePagerDutyRuleset() *schema.Resource {
 				},
 			},
 			"routing_keys": {
-				Type:     schema.TypeList,
-				Computed: true,
+				Type:      schema.TypeList,
+				Computed:  true,
+				Sensitive: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -53,13 +54,15 @@ func resourcePagerDutyRuleset() *schema.Resource {
 	}
 }
 
-func buildRulesetStruct(d *schema.ResourceData) *pagerduty.Ruleset {
+func buildRulesetStruct(d *schema.ResourceData, meta interface{}) *pagerduty.Ruleset {
 	ruleset := &pagerduty.Ruleset{
 		Name: d.Get("name").(string),
 	}
 
 	if attr, ok := d.GetOk("team"); ok {
 		ruleset.Team = expandTeam(attr)
+	} else if team := applyDefaultTeamID("", meta); team != "" {
+		ruleset.Team = &pagerduty.RulesetObject{ID: team}
 	}
 
 	if attr, ok := d.GetOk("routing_keys"); ok {
@@ -126,7 +129,7 @@ func fetchPagerDutyRuleset(d *schema.ResourceData, meta interface{}, errCallback
 		d.Set("type", ruleset.Type)
 
 		// if ruleset is found set to ResourceData
-		if ruleset.Team != nil {
+		if ruleset.Team != nil && !isUnconfiguredDefaultTeam(d, "team", ruleset.Team.ID, meta) {
 			d.Set("team", flattenTeam(ruleset.Team))
 		}
 		d.Set("routing_keys", ruleset.RoutingKeys)
@@ -141,7 +144,7 @@ func resourcePagerDutyRulesetCreate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	ruleset := buildRulesetStruct(d)
+	ruleset := buildRulesetStruct(d, meta)
 
 	log.Printf("[INFO] Creating PagerDuty ruleset: %s", ruleset.Name)
 
@@ -175,7 +178,7 @@ func resourcePagerDutyRulesetUpdate(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	ruleset := buildRulesetStruct(d)
+	ruleset := buildRulesetStruct(d, meta)
 
 	log.Printf("[INFO] Updating PagerDuty ruleset: %s", d.Id())
 
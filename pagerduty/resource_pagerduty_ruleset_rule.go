@@ -1,10 +1,12 @@
 package pagerduty
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
 	"strings"
 	"time"
 
@@ -22,6 +24,7 @@ func resourcePagerDutyRulesetRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyRulesetRuleImport,
 		},
+		CustomizeDiff: checkRuleVariables,
 		Schema: map[string]*schema.Schema{
 			"ruleset": {
 				Type:     schema.TypeString,
@@ -87,8 +90,10 @@ func resourcePagerDutyRulesetRule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"scheduled_weekly": {
-							Type:     schema.TypeList,
-							Optional: true,
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"time_frame.0.active_between"},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"timezone": {
@@ -121,8 +126,10 @@ func resourcePagerDutyRulesetRule() *schema.Resource {
 							},
 						},
 						"active_between": {
-							Type:     schema.TypeList,
-							Optional: true,
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"time_frame.0.scheduled_weekly"},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"start_time": {
@@ -293,8 +300,9 @@ func resourcePagerDutyRulesetRule() *schema.Resource {
 							Optional: true,
 						},
 						"type": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateValueDiagFunc([]string{"regex"}),
 						},
 						"parameters": {
 							Type:     schema.TypeList,
@@ -372,7 +380,11 @@ func expandTimeFrame(v interface{}) *pagerduty.RuleTimeFrame {
 	tFrame := new(pagerduty.RuleTimeFrame)
 
 	for _, tfi := range v.([]interface{}) {
-		tfm := tfi.(map[string]interface{})
+		// An empty time_frame block has no attributes to read.
+		tfm, ok := tfi.(map[string]interface{})
+		if !ok {
+			continue
+		}
 
 		if tfm["scheduled_weekly"] != nil {
 			tFrame.ScheduledWeekly = expandScheduledWeekly(tfm["scheduled_weekly"].(interface{}))
@@ -567,6 +579,49 @@ func expandRuleVariables(v interface{}) []*pagerduty.RuleVariable {
 	return ruleVariables
 }
 
+// checkRuleVariables checks at plan time that the variables of a rule are
+// named and extract their value from a path of the event with a valid regular
+// expression, which the API otherwise rejects without telling which variable
+// is wrong.
+func checkRuleVariables(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("variable") {
+		return nil
+	}
+
+	for i, v := range diff.Get("variable").([]interface{}) {
+		variable, _ := v.(map[string]interface{})
+		key := fmt.Sprintf("variable.%d", i)
+
+		if name, _ := variable["name"].(string); name == "" && diff.NewValueKnown(key+".name") {
+			return fmt.Errorf("%s: the name of the variable must be set", key)
+		}
+
+		params, _ := variable["parameters"].([]interface{})
+		if len(params) != 1 || isNilFunc(params[0]) {
+			if diff.NewValueKnown(key + ".parameters") {
+				return fmt.Errorf("%s: the variable must have exactly one parameters block setting its value and path", key)
+			}
+			continue
+		}
+		p := params[0].(map[string]interface{})
+		if path, _ := p["path"].(string); path == "" && diff.NewValueKnown(key+".parameters.0.path") {
+			return fmt.Errorf("%s.parameters.0.path: the path of the event field the variable is extracted from must be set", key)
+		}
+		if !diff.NewValueKnown(key + ".parameters.0.value") {
+			continue
+		}
+		value, _ := p["value"].(string)
+		if value == "" {
+			return fmt.Errorf("%s.parameters.0.value: the regular expression extracting the variable must be set", key)
+		}
+		if _, err := regexp.Compile(value); err != nil {
+			return fmt.Errorf("%s.parameters.0.value: %q is not a valid regular expression: %s", key, value, err)
+		}
+	}
+
+	return nil
+}
+
 func expandVariableParameters(v interface{}) *pagerduty.RuleVariableParameter {
 	var parm *pagerduty.RuleVariableParameter
 
@@ -598,6 +653,10 @@ func flattenRuleVariables(v []*pagerduty.RuleVariable) []map[string]interface{}
 }
 
 func flattenVariableParamters(p *pagerduty.RuleVariableParameter) []interface{} {
+	if p == nil {
+		return []interface{}{}
+	}
+
 	flattenedParams := map[string]interface{}{
 		"path":  p.Path,
 		"value": p.Value,
@@ -713,9 +772,26 @@ func flattenExtractions(rae []*pagerduty.RuleActionExtraction) []interface{} {
 	return flatExtractList
 }
 
+// keepEmptyTimeFrame keeps the empty time_frame block of the state, which
+// the API doesn't return as it's the same as having no time frame at all.
+func keepEmptyTimeFrame(d *schema.ResourceData, timeFrame []map[string]interface{}) []map[string]interface{} {
+	if len(timeFrame) > 0 || d.Get("time_frame.#").(int) == 0 {
+		return timeFrame
+	}
+	if d.Get("time_frame.0.scheduled_weekly.#").(int) > 0 || d.Get("time_frame.0.active_between.#").(int) > 0 {
+		return timeFrame
+	}
+
+	return []map[string]interface{}{{}}
+}
+
 func flattenTimeFrame(timeframe *pagerduty.RuleTimeFrame) []map[string]interface{} {
 	var tfMap []map[string]interface{}
 
+	if timeframe == nil || (timeframe.ScheduledWeekly == nil && timeframe.ActiveBetween == nil) {
+		return tfMap
+	}
+
 	tm := make(map[string]interface{})
 
 	if timeframe.ScheduledWeekly != nil {
@@ -754,6 +830,9 @@ func resourcePagerDutyRulesetRuleCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	rule := buildRulesetRuleStruct(d)
+	if err := resolveRuleActionsPriority(meta.(*Config), rule.Actions); err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Creating PagerDuty ruleset rule for ruleset: %s", rule.Ruleset.ID)
 
@@ -841,14 +920,15 @@ func resourcePagerDutyRulesetRuleRead(d *schema.ResourceData, meta interface{})
 				d.Set("conditions", flattenConditions(rule.Conditions))
 			}
 			if rule.Actions != nil {
+				preserveRuleActionsPriorityRef(meta.(*Config), d, rule.Actions)
 				d.Set("actions", flattenActions(rule.Actions))
 			}
-			if rule.TimeFrame != nil {
-				d.Set("time_frame", flattenTimeFrame(rule.TimeFrame))
-			}
-			if rule.Variables != nil {
-				d.Set("variable", flattenRuleVariables(rule.Variables))
-			}
+			// Always set the time frame so that a time frame removed outside of
+			// Terraform shows up in the plan.
+			d.Set("time_frame", keepEmptyTimeFrame(d, flattenTimeFrame(rule.TimeFrame)))
+			// Always set the variables so that variables removed outside of
+			// Terraform show up in the plan.
+			d.Set("variable", flattenRuleVariables(rule.Variables))
 			d.Set("position", rule.Position)
 			d.Set("disabled", rule.Disabled)
 			d.Set("ruleset", rulesetID)
@@ -864,6 +944,9 @@ func resourcePagerDutyRulesetRuleUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	rule := buildRulesetRuleStruct(d)
+	if err := resolveRuleActionsPriority(meta.(*Config), rule.Actions); err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Updating PagerDuty ruleset rule: %s", d.Id())
 	rulesetID := d.Get("ruleset").(string)
@@ -1,11 +1,19 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -68,6 +76,77 @@ func TestAccPagerDutyRulesetRule_Basic(t *testing.T) {
 						"pagerduty_ruleset_rule.foo", "actions.0.annotate.0.value", ruleUpdated),
 				),
 			},
+			// Disabling the rule again updates it in place
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfig(team, ruleset, rule),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pagerduty_ruleset_rule.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyRulesetRuleExists("pagerduty_ruleset_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "disabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyRulesetRule_TimeFrame(t *testing.T) {
+	ruleset := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	activeBetween := `
+		active_between {
+			start_time = 1000000000000
+			end_time = 4000000000000
+		}`
+	scheduledWeekly := `
+		scheduled_weekly {
+			weekdays = [1,5]
+			timezone = "America/New_York"
+			start_time = 1000000
+			duration = 3600000
+		}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyRulesetRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyRulesetRuleConfigTimeFrame(team, ruleset, activeBetween+scheduledWeekly),
+				ExpectError: regexp.MustCompile("conflicts with"),
+			},
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfigTimeFrame(team, ruleset, activeBetween),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyRulesetRuleExists("pagerduty_ruleset_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "time_frame.#", "1"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "time_frame.0.active_between.0.start_time", "1000000000000"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "time_frame.0.active_between.0.end_time", "4000000000000"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "time_frame.0.scheduled_weekly.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfigTimeFrame(team, ruleset, scheduledWeekly),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyRulesetRuleExists("pagerduty_ruleset_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "time_frame.0.active_between.#", "0"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "time_frame.0.scheduled_weekly.0.weekdays.#", "2"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "time_frame.0.scheduled_weekly.0.timezone", "America/New_York"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "time_frame.0.scheduled_weekly.0.duration", "3600000"),
+				),
+			},
 		},
 	})
 }
@@ -215,6 +294,218 @@ func TestAccPagerDutyRulesetRule_CatchAllRuleRoute(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyRulesetRule_Variables(t *testing.T) {
+	ruleset := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyRulesetRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyRulesetRuleConfigVariable(team, ruleset, "host", "payload.source", "(.*"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`variable.0.parameters.0.value: "\(\.\*" is not a valid regular expression`),
+			},
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfigVariable(team, ruleset, "host", "payload.source", "^(.*)[.]example[.]com$"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyRulesetRuleExists("pagerduty_ruleset_rule.foo"),
+					resource.TestCheckResourceAttr("pagerduty_ruleset_rule.foo", "variable.#", "1"),
+					resource.TestCheckResourceAttr("pagerduty_ruleset_rule.foo", "variable.0.name", "host"),
+					resource.TestCheckResourceAttr("pagerduty_ruleset_rule.foo", "variable.0.type", "regex"),
+					resource.TestCheckResourceAttr("pagerduty_ruleset_rule.foo", "variable.0.parameters.0.path", "payload.source"),
+					resource.TestCheckResourceAttr("pagerduty_ruleset_rule.foo", "variable.0.parameters.0.value", "^(.*)[.]example[.]com$"),
+					resource.TestCheckResourceAttr("pagerduty_ruleset_rule.foo", "actions.0.extractions.0.template", "Host {{host}}"),
+				),
+			},
+			{
+				ResourceName:      "pagerduty_ruleset_rule.foo",
+				ImportStateIdFunc: testAccCheckPagerDutyRulesetRuleID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccCheckPagerDutyRulesetRuleConfigVariable(team, ruleset, "host", "payload.source", "^(.*)[.]example[.]com$"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestCheckRuleVariables(t *testing.T) {
+	variable := func(name, path, value string) map[string]interface{} {
+		return map[string]interface{}{
+			"type":       "regex",
+			"name":       name,
+			"parameters": []interface{}{map[string]interface{}{"path": path, "value": value}},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		variable map[string]interface{}
+		err      string
+	}{
+		{name: "valid", variable: variable("host", "payload.source", "(.*)")},
+		{name: "no name", variable: variable("", "payload.source", "(.*)"), err: "variable.0: the name of the variable must be set"},
+		{name: "no path", variable: variable("host", "", "(.*)"), err: "variable.0.parameters.0.path: the path of the event field the variable is extracted from must be set"},
+		{name: "no regex", variable: variable("host", "payload.source", ""), err: "variable.0.parameters.0.value: the regular expression extracting the variable must be set"},
+		{name: "invalid regex", variable: variable("host", "payload.source", "(.*"), err: `variable.0.parameters.0.value: "(.*" is not a valid regular expression`},
+		{
+			name:     "no parameters",
+			variable: map[string]interface{}{"type": "regex", "name": "host"},
+			err:      "variable.0: the variable must have exactly one parameters block setting its value and path",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			raw := sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+				"ruleset":  "PRSET12",
+				"variable": []interface{}{c.variable},
+			})
+
+			_, err := resourcePagerDutyRulesetRule().Diff(context.Background(), nil, raw, &Config{})
+			if c.err == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if c.err != "" && (err == nil || !strings.HasPrefix(err.Error(), c.err)) {
+				t.Errorf("expected the error %q, got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestResourcePagerDutyRulesetRuleRead_Variables(t *testing.T) {
+	rule := `{"rule":{"id":"PRULE12","position":0,"variables":[
+		{"type":"regex","name":"host","parameters":{"value":"(.*)","path":"payload.source"}}
+	]}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/rulesets/PRSET12/rules/PRULE12" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+			return
+		}
+		w.Write([]byte(rule))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := resourcePagerDutyRulesetRule()
+	d := r.TestResourceData()
+	d.SetId("PRULE12")
+	d.Set("ruleset", "PRSET12")
+	if err := resourcePagerDutyRulesetRuleRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"variable.#":                    "1",
+		"variable.0.name":               "host",
+		"variable.0.type":               "regex",
+		"variable.0.parameters.0.path":  "payload.source",
+		"variable.0.parameters.0.value": "(.*)",
+	}
+	state := d.State()
+	for k, v := range expected {
+		if got := state.Attributes[k]; got != v {
+			t.Errorf("expected %s to be %q, got %q", k, v, got)
+		}
+	}
+
+	// Variables removed outside of Terraform are removed from the state.
+	rule = `{"rule":{"id":"PRULE12","position":0}}`
+	d = r.Data(d.State())
+	if err := resourcePagerDutyRulesetRuleRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := d.Get("variable.#"); got != 0 {
+		t.Errorf("expected the removed variables to be removed from the state, got %v", got)
+	}
+}
+
+func TestValidatePagerDutyRulesetRule_TimeFrame(t *testing.T) {
+	activeBetween := map[string]interface{}{"start_time": 1000000000000, "end_time": 4000000000000}
+	scheduledWeekly := map[string]interface{}{"weekdays": []interface{}{1, 5}, "timezone": "America/New_York", "start_time": 1000000, "duration": 3600000}
+
+	cases := []struct {
+		name      string
+		timeFrame map[string]interface{}
+		err       string
+	}{
+		{name: "empty", timeFrame: map[string]interface{}{}},
+		{name: "active between", timeFrame: map[string]interface{}{"active_between": []interface{}{activeBetween}}},
+		{name: "scheduled weekly", timeFrame: map[string]interface{}{"scheduled_weekly": []interface{}{scheduledWeekly}}},
+		{
+			name:      "both",
+			timeFrame: map[string]interface{}{"active_between": []interface{}{activeBetween}, "scheduled_weekly": []interface{}{scheduledWeekly}},
+			err:       "conflicts with",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			raw := sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+				"ruleset":    "PRSET12",
+				"time_frame": []interface{}{c.timeFrame},
+			})
+
+			diags := resourcePagerDutyRulesetRule().Validate(raw)
+			if c.err == "" && diags.HasError() {
+				t.Errorf("unexpected error: %v", diags)
+			}
+			if c.err != "" && (!diags.HasError() || !strings.Contains(diags[0].Detail, c.err)) {
+				t.Errorf("expected the error %q, got %v", c.err, diags)
+			}
+		})
+	}
+}
+
+func TestResourcePagerDutyRulesetRuleRead_EmptyTimeFrame(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"rule":{"id":"PRULE12","position":0}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := resourcePagerDutyRulesetRule()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"ruleset":    "PRSET12",
+		"time_frame": []interface{}{map[string]interface{}{}},
+	})
+	d.SetId("PRULE12")
+	if err := resourcePagerDutyRulesetRuleRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := d.State().Attributes["time_frame.#"]; got != "1" {
+		t.Errorf("expected the empty time frame to be kept, got %q time frames", got)
+	}
+
+	// A time frame removed outside of Terraform is removed from the state.
+	d = r.TestResourceData()
+	d.SetId("PRULE12")
+	d.Set("ruleset", "PRSET12")
+	d.Set("time_frame", []interface{}{map[string]interface{}{"active_between": []interface{}{map[string]interface{}{"start_time": 1000000000000, "end_time": 4000000000000}}}})
+	if err := resourcePagerDutyRulesetRuleRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := d.Get("time_frame.#"); got != 0 {
+		t.Errorf("expected the removed time frame to be removed from the state, got %v", got)
+	}
+}
+
 func testAccCheckPagerDutyRulesetRuleDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -322,6 +613,86 @@ resource "pagerduty_ruleset_rule" "foo" {
 `, team, ruleset, rule)
 }
 
+func testAccCheckPagerDutyRulesetRuleConfigVariable(team, ruleset, name, path, value string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+	name = "%s"
+}
+
+resource "pagerduty_ruleset" "foo" {
+	name = "%s"
+	team {
+		id = pagerduty_team.foo.id
+	}
+}
+
+resource "pagerduty_ruleset_rule" "foo" {
+	ruleset  = pagerduty_ruleset.foo.id
+	position = 0
+
+	conditions {
+		operator = "and"
+		subconditions {
+			operator = "exists"
+			parameter {
+				path = "payload.source"
+			}
+		}
+	}
+	variable {
+		type = "regex"
+		name = "%[3]s"
+		parameters {
+			path  = "%[4]s"
+			value = "%[5]s"
+		}
+	}
+	actions {
+		extractions {
+			target   = "summary"
+			template = "Host {{%[3]s}}"
+		}
+	}
+}
+`, team, ruleset, name, path, value)
+}
+
+func testAccCheckPagerDutyRulesetRuleConfigTimeFrame(team, ruleset, timeFrame string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+	name = "%s"
+}
+
+resource "pagerduty_ruleset" "foo" {
+	name = "%s"
+	team {
+		id = pagerduty_team.foo.id
+	}
+}
+resource "pagerduty_ruleset_rule" "foo" {
+	ruleset = pagerduty_ruleset.foo.id
+	position = 0
+	time_frame {%s
+	}
+	conditions {
+		operator = "and"
+		subconditions {
+			operator = "contains"
+			parameter {
+				value = "disk space"
+				path = "payload.summary"
+			}
+		}
+	}
+	actions {
+		severity {
+			value = "warning"
+		}
+	}
+}
+`, team, ruleset, timeFrame)
+}
+
 func testAccCheckPagerDutyRulesetRuleConfigUpdated(team, ruleset, rule string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_team" "foo" {
@@ -26,8 +26,10 @@ func resourcePagerDutySchedule() *schema.Resource {
 			ln := diff.Get("layer.#").(int)
 			for li := 0; li <= ln; li++ {
 				rn := diff.Get(fmt.Sprintf("layer.%d.restriction.#", li)).(int)
+				hasWeeklyRestriction := false
 				for ri := 0; ri <= rn; ri++ {
 					t := diff.Get(fmt.Sprintf("layer.%d.restriction.%d.type", li, ri)).(string)
+					hasWeeklyRestriction = hasWeeklyRestriction || t == "weekly_restriction"
 					isStartDayOfWeekSetWhenDailyRestrictionType := t == "daily_restriction" && diff.Get(fmt.Sprintf("layer.%d.restriction.%d.start_day_of_week", li, ri)).(int) != 0
 					if isStartDayOfWeekSetWhenDailyRestrictionType {
 						return fmt.Errorf("start_day_of_week must only be set for a weekly_restriction schedule restriction type")
@@ -41,6 +43,22 @@ func resourcePagerDutySchedule() *schema.Resource {
 						return fmt.Errorf("duration_seconds for a daily_restriction schedule restriction type must be shorter than a day")
 					}
 				}
+				if li < ln && diff.NewValueKnown(fmt.Sprintf("layer.%d.rotation_turn_length_seconds", li)) && diff.NewValueKnown(fmt.Sprintf("layer.%d.start", li)) && diff.NewValueKnown(fmt.Sprintf("layer.%d.rotation_virtual_start", li)) && diff.NewValueKnown(fmt.Sprintf("layer.%d.end", li)) {
+					err := validateScheduleLayerRotation(
+						li,
+						diff.Get(fmt.Sprintf("layer.%d.rotation_turn_length_seconds", li)).(int),
+						hasWeeklyRestriction,
+						diff.Get(fmt.Sprintf("layer.%d.start", li)).(string),
+						diff.Get(fmt.Sprintf("layer.%d.rotation_virtual_start", li)).(string),
+						diff.Get(fmt.Sprintf("layer.%d.end", li)).(string),
+					)
+					if err != nil {
+						return err
+					}
+				}
+			}
+			if diff.HasChange("layer") {
+				return diff.SetNewComputed("users")
 			}
 			return nil
 		},
@@ -70,6 +88,16 @@ func resourcePagerDutySchedule() *schema.Resource {
 				Default:  "Managed by Terraform",
 			},
 
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"self": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"layer": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -181,6 +209,15 @@ func resourcePagerDutySchedule() *schema.Resource {
 				},
 			},
 
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The unique IDs of the users of the layers of the schedule",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"final_schedule": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -201,6 +238,40 @@ func resourcePagerDutySchedule() *schema.Resource {
 	}
 }
 
+const scheduleWeekSeconds = 7 * 24 * 3600
+
+// validateScheduleLayerRotation checks the rotation of a layer against the
+// misconfigurations the API refuses with unclear errors. The turns of the
+// layers with weekly restrictions have to line up with the weeks, so their
+// length has to divide a week or be a whole number of weeks, and a layer
+// ending before it starts, or before its rotation starts, would never have a
+// turn.
+func validateScheduleLayerRotation(layer, turnLength int, hasWeeklyRestriction bool, start, virtualStart, end string) error {
+	if hasWeeklyRestriction && turnLength > 0 && scheduleWeekSeconds%turnLength != 0 && turnLength%scheduleWeekSeconds != 0 {
+		return fmt.Errorf("rotation_turn_length_seconds of layer %d must divide a week (%d seconds) or be a multiple of it when the layer has a weekly_restriction, got %d", layer, scheduleWeekSeconds, turnLength)
+	}
+
+	if end == "" {
+		return nil
+	}
+	e, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return nil
+	}
+	if st, err := time.Parse(time.RFC3339, start); err == nil && !st.Before(e) {
+		return fmt.Errorf("end of layer %d must be after its start", layer)
+	}
+	vs, err := time.Parse(time.RFC3339, virtualStart)
+	if err != nil {
+		return nil
+	}
+	if !vs.Before(e) {
+		return fmt.Errorf("rotation_virtual_start of layer %d must be before its end, the layer would end before its first turn", layer)
+	}
+
+	return nil
+}
+
 func buildScheduleStruct(d *schema.ResourceData) (*pagerduty.Schedule, error) {
 	layers, err := expandScheduleLayers(d.Get("layer"))
 	if err != nil {
@@ -234,6 +305,7 @@ func resourcePagerDutyScheduleCreate(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
+	schedule.Teams = applyDefaultTeam(schedule.Teams, meta)
 
 	o := &pagerduty.CreateScheduleOptions{}
 
@@ -258,6 +330,21 @@ func resourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) err
 	return fetchSchedule(d, meta, handleNotFoundError)
 }
 
+// scheduleRenderWindow is how far ahead of the time of reading a schedule its
+// layers are rendered, the coverage percentages are computed over it.
+const scheduleRenderWindow = 7 * 24 * time.Hour
+
+// scheduleRenderOptions requests the rendering of a schedule from now on, the
+// API only computes the rendered coverage of the layers of schedules read in a
+// time range.
+func scheduleRenderOptions(now time.Time) *pagerduty.GetScheduleOptions {
+	now = now.UTC().Truncate(time.Second)
+	return &pagerduty.GetScheduleOptions{
+		Since: now.Format(time.RFC3339),
+		Until: now.Add(scheduleRenderWindow).Format(time.RFC3339),
+	}
+}
+
 func fetchSchedule(d *schema.ResourceData, meta interface{}, errCallback func(error, *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -265,7 +352,7 @@ func fetchSchedule(d *schema.ResourceData, meta interface{}, errCallback func(er
 	}
 
 	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
-		schedule, _, err := client.Schedules.Get(d.Id(), &pagerduty.GetScheduleOptions{})
+		schedule, _, err := client.Schedules.Get(d.Id(), scheduleRenderOptions(time.Now()))
 		if err != nil {
 			log.Printf("[WARN] Schedule read error")
 			if isErrCode(err, http.StatusBadRequest) {
@@ -283,8 +370,10 @@ func fetchSchedule(d *schema.ResourceData, meta interface{}, errCallback func(er
 			d.Set("name", schedule.Name)
 			d.Set("time_zone", schedule.TimeZone)
 			d.Set("description", schedule.Description)
+			d.Set("html_url", schedule.HTMLURL)
+			d.Set("self", schedule.Self)
 
-			layers, err := flattenScheduleLayers(schedule.ScheduleLayers)
+			layers, err := flattenScheduleLayers(schedule.ScheduleLayers, configuredEndingScheduleLayers(d))
 			if err != nil {
 				return retry.NonRetryableError(err)
 			}
@@ -292,7 +381,10 @@ func fetchSchedule(d *schema.ResourceData, meta interface{}, errCallback func(er
 			if err := d.Set("layer", layers); err != nil {
 				return retry.NonRetryableError(err)
 			}
-			if err := d.Set("teams", flattenShedTeams(schedule.Teams)); err != nil {
+			if err := d.Set("users", flattenScheduleLayersUsers(layers)); err != nil {
+				return retry.NonRetryableError(fmt.Errorf("error setting users: %s", err))
+			}
+			if err := d.Set("teams", omitDefaultTeam(d, reconcileSchedTeams(d, schedule.Teams), meta)); err != nil {
 				return retry.NonRetryableError(fmt.Errorf("error setting teams: %s", err))
 			}
 			if err := d.Set("final_schedule", flattenScheFinalSchedule(schedule.FinalSchedule)); err != nil {
@@ -321,6 +413,7 @@ func resourcePagerDutyScheduleUpdate(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
+	schedule.Teams = applyDefaultTeam(schedule.Teams, meta)
 
 	opts := &pagerduty.UpdateScheduleOptions{}
 
@@ -549,7 +642,26 @@ func expandScheduleLayers(v interface{}) ([]*pagerduty.ScheduleLayer, error) {
 	return scheduleLayers, nil
 }
 
-func flattenScheduleLayers(v []*pagerduty.ScheduleLayer) ([]map[string]interface{}, error) {
+// configuredEndingScheduleLayers returns the IDs of the layers of the schedule
+// with an end, read back by flattenScheduleLayers even once ended.
+func configuredEndingScheduleLayers(d *schema.ResourceData) map[string]bool {
+	ending := make(map[string]bool)
+	for _, l := range d.Get("layer").([]interface{}) {
+		rl, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if id, end := rl["id"].(string), rl["end"].(string); id != "" && end != "" {
+			ending[id] = true
+		}
+	}
+	return ending
+}
+
+// flattenScheduleLayers leaves out the layers which have ended, except the ones
+// of keepEnded, which are the layers configured with an end. These are kept so
+// a layer reaching the end it was given doesn't show as a change.
+func flattenScheduleLayers(v []*pagerduty.ScheduleLayer, keepEnded map[string]bool) ([]map[string]interface{}, error) {
 	var scheduleLayers []map[string]interface{}
 
 	for _, sl := range v {
@@ -557,7 +669,7 @@ func flattenScheduleLayers(v []*pagerduty.ScheduleLayer) ([]map[string]interface
 		// Here we check each layer and if it has been ended we don't read it back
 		// because it's not relevant anymore.
 		endStr := stringPtrToStringType(sl.End)
-		if endStr != "" {
+		if endStr != "" && !keepEnded[sl.ID] {
 			end, err := timeToUTC(endStr)
 			if err != nil {
 				return nil, err
@@ -616,6 +728,26 @@ func flattenScheduleLayers(v []*pagerduty.ScheduleLayer) ([]map[string]interface
 	return resultReversed, nil
 }
 
+// flattenScheduleLayersUsers returns the IDs of the users of the flattened
+// layers, without duplicates and in the order they first appear.
+func flattenScheduleLayersUsers(layers []map[string]interface{}) []string {
+	users := []string{}
+	seen := make(map[string]bool)
+
+	for _, layer := range layers {
+		layerUsers, _ := layer["users"].([]string)
+		for _, id := range layerUsers {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			users = append(users, id)
+		}
+	}
+
+	return users
+}
+
 // the expandShedTeams and flattenSchedTeams are based on the expandTeams and flattenTeams functions in the user
 // resource. added these functions here for maintainability
 func expandSchedTeams(v interface{}) []*pagerduty.TeamReference {
@@ -641,6 +773,42 @@ func flattenShedTeams(teams []*pagerduty.TeamReference) []string {
 	return res
 }
 
+// reconcileSchedTeams flattens the teams reported by the API while keeping the
+// order in which they are stored in state. Teams which are in state but are no
+// longer reported by the API (e.g. they were deleted outside of Terraform) are
+// dropped from state instead of failing the read.
+func reconcileSchedTeams(d *schema.ResourceData, teams []*pagerduty.TeamReference) []string {
+	apiTeams := flattenShedTeams(teams)
+
+	reported := make(map[string]bool, len(apiTeams))
+	for _, id := range apiTeams {
+		reported[id] = true
+	}
+
+	res := []string{}
+	seen := make(map[string]bool, len(apiTeams))
+	for _, t := range d.Get("teams").([]interface{}) {
+		id, ok := t.(string)
+		if !ok || id == "" {
+			continue
+		}
+		if !reported[id] {
+			log.Printf("[INFO] Removing team %s from state of schedule %s because it is no longer reported by the API", id, d.Id())
+			continue
+		}
+		res = append(res, id)
+		seen[id] = true
+	}
+
+	for _, id := range apiTeams {
+		if !seen[id] {
+			res = append(res, id)
+		}
+	}
+
+	return res
+}
+
 func flattenScheFinalSchedule(finalSche *pagerduty.SubSchedule) []map[string]interface{} {
 	var res []map[string]interface{}
 	elem := make(map[string]interface{})
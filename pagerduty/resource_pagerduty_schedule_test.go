@@ -70,6 +70,10 @@ func TestAccPagerDutySchedule_Basic(t *testing.T) {
 				Config: testAccCheckPagerDutyScheduleConfig(username, email, schedule, location, start, rotationVirtualStart),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPagerDutyScheduleExists("pagerduty_schedule.foo"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_schedule.foo", "html_url"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_schedule.foo", "self"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_schedule.foo", "name", schedule),
 					resource.TestCheckResourceAttr(
@@ -82,10 +86,10 @@ func TestAccPagerDutySchedule_Basic(t *testing.T) {
 						"pagerduty_schedule.foo", "layer.0.name", "foo"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_schedule.foo", "layer.0.start", start),
-					resource.TestCheckResourceAttr(
-						"pagerduty_schedule.foo", "layer.0.rendered_coverage_percentage", "0.00"),
-					resource.TestCheckResourceAttr(
-						"pagerduty_schedule.foo", "final_schedule.0.rendered_coverage_percentage", "0.00"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_schedule.foo", "layer.0.rendered_coverage_percentage"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_schedule.foo", "final_schedule.0.rendered_coverage_percentage"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_schedule.foo", "layer.0.rotation_virtual_start", rotationVirtualStart),
 				),
@@ -205,6 +209,49 @@ func TestAccPagerDutyScheduleWithTeams_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyScheduleWithTeams_ExternallyDestroyedTeam(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	location := "America/New_York"
+	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyScheduleWithTeamsConfig(username, email, schedule, location, start, rotationVirtualStart, team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyScheduleExists("pagerduty_schedule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_schedule.foo", "teams.#", "1"),
+				),
+			},
+			// Validating that a team removed outside of Terraform is dropped from
+			// the schedule state and planned for re-creation instead of failing
+			{
+				Config: testAccCheckPagerDutyScheduleWithTeamsConfig(username, email, schedule, location, start, rotationVirtualStart, team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExternallyDestroyScheduleTeam("pagerduty_team.foo"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckPagerDutyScheduleWithTeamsConfig(username, email, schedule, location, start, rotationVirtualStart, team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyScheduleExists("pagerduty_schedule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_schedule.foo", "teams.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutySchedule_BasicWithExternalDestroyHandling(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -628,6 +675,151 @@ func TestAccPagerDutySchedule_BasicWeek(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutySchedule_WeeklyRotationValidation(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	location := "America/New_York"
+	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	rotationVirtualStart := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyScheduleConfigWeeklyRotation(username, email, schedule, location, start, rotationVirtualStart, 5*86400),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("rotation_turn_length_seconds of layer 0 must divide a week \\(604800 seconds\\) or be a multiple of it when the layer has a weekly_restriction, got 432000"),
+			},
+			{
+				Config: testAccCheckPagerDutyScheduleConfigWeeklyRotation(username, email, schedule, location, start, rotationVirtualStart, 7*86400),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyScheduleExists("pagerduty_schedule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_schedule.foo", "layer.0.rotation_turn_length_seconds", "604800"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_schedule.foo", "layer.0.restriction.0.type", "weekly_restriction"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateScheduleLayerRotation(t *testing.T) {
+	cases := []struct {
+		turnLength           int
+		hasWeeklyRestriction bool
+		start, virtualStart  string
+		end                  string
+		valid                bool
+	}{
+		{turnLength: 86400, hasWeeklyRestriction: true, valid: true},
+		{turnLength: 7 * 86400, hasWeeklyRestriction: true, valid: true},
+		{turnLength: 14 * 86400, hasWeeklyRestriction: true, valid: true},
+		{turnLength: 12 * 3600, hasWeeklyRestriction: true, valid: true},
+		{turnLength: 5 * 86400, hasWeeklyRestriction: true, valid: false},
+		{turnLength: 10 * 86400, hasWeeklyRestriction: true, valid: false},
+		{turnLength: 5 * 86400, hasWeeklyRestriction: false, valid: true},
+		{turnLength: 86400, virtualStart: "2024-01-01T00:00:00Z", end: "2024-02-01T00:00:00Z", valid: true},
+		{turnLength: 86400, virtualStart: "2024-02-01T00:00:00Z", end: "2024-01-01T00:00:00Z", valid: false},
+		{turnLength: 86400, virtualStart: "2024-01-01T05:00:00+05:00", end: "2024-01-01T00:00:00Z", valid: false},
+		{turnLength: 86400, start: "2024-01-01T00:00:00Z", virtualStart: "2023-12-01T00:00:00Z", end: "2024-02-01T00:00:00Z", valid: true},
+		{turnLength: 86400, start: "2024-03-01T00:00:00Z", virtualStart: "2023-12-01T00:00:00Z", end: "2024-02-01T00:00:00Z", valid: false},
+		{turnLength: 86400, start: "2024-02-01T01:00:00+01:00", virtualStart: "2023-12-01T00:00:00Z", end: "2024-02-01T00:00:00Z", valid: false},
+	}
+	for _, c := range cases {
+		err := validateScheduleLayerRotation(0, c.turnLength, c.hasWeeklyRestriction, c.start, c.virtualStart, c.end)
+		if c.valid && err != nil {
+			t.Errorf("expected %+v to be valid, got %s", c, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("expected %+v to be invalid", c)
+		}
+	}
+}
+
+func TestFlattenScheduleLayers_Ended(t *testing.T) {
+	past := time.Now().UTC().Add(-24 * time.Hour).Format(time.RFC3339)
+	future := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	layers := []*pagerduty.ScheduleLayer{
+		{ID: "PLAYER1", Start: "2024-01-01T00:00:00Z", RotationVirtualStart: "2024-01-01T00:00:00Z"},
+		{ID: "PLAYER2", Start: "2024-01-01T00:00:00Z", End: &future, RotationVirtualStart: "2024-01-01T00:00:00Z"},
+		{ID: "PLAYER3", Start: "2024-01-01T00:00:00Z", End: &past, RotationVirtualStart: "2024-01-01T00:00:00Z"},
+	}
+
+	cases := []struct {
+		keepEnded map[string]bool
+		expected  []string
+	}{
+		{expected: []string{"PLAYER2", "PLAYER1"}},
+		{keepEnded: map[string]bool{"PLAYER2": true}, expected: []string{"PLAYER2", "PLAYER1"}},
+		{keepEnded: map[string]bool{"PLAYER3": true}, expected: []string{"PLAYER3", "PLAYER2", "PLAYER1"}},
+	}
+	for _, c := range cases {
+		flattened, err := flattenScheduleLayers(layers, c.keepEnded)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var ids []string
+		for _, l := range flattened {
+			ids = append(ids, l["id"].(string))
+		}
+		if strings.Join(ids, ",") != strings.Join(c.expected, ",") {
+			t.Errorf("expected the layers %v to be read keeping the ended ones of %v, got %v", c.expected, c.keepEnded, ids)
+		}
+	}
+
+	r := resourcePagerDutySchedule()
+	d := r.TestResourceData()
+	d.Set("layer", []interface{}{
+		map[string]interface{}{"id": "PLAYER1", "start": "2024-01-01T00:00:00Z"},
+		map[string]interface{}{"id": "PLAYER3", "start": "2024-01-01T00:00:00Z", "end": past},
+		map[string]interface{}{"start": "2024-01-01T00:00:00Z", "end": future},
+	})
+	if got := configuredEndingScheduleLayers(d); len(got) != 1 || !got["PLAYER3"] {
+		t.Errorf("expected only PLAYER3 to be a configured ending layer, got %v", got)
+	}
+}
+
+func TestAccPagerDutySchedule_EndingLayer(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	schedule := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	location := "America/New_York"
+	start := timeNowInLoc(location).Add(24 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	end := timeNowInLoc(location).Add(72 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+	endBeforeStart := timeNowInLoc(location).Add(12 * time.Hour).Round(1 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyScheduleConfigEndingLayer(username, email, schedule, location, start, start, end),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyScheduleExists("pagerduty_schedule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_schedule.foo", "layer.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_schedule.foo", "layer.0.end"),
+				),
+			},
+			{
+				Config:             testAccCheckPagerDutyScheduleConfigEndingLayer(username, email, schedule, location, start, start, end),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config:      testAccCheckPagerDutyScheduleConfigEndingLayer(username, email, schedule, location, start, endBeforeStart, endBeforeStart),
+				ExpectError: regexp.MustCompile("end of layer 0 must be after its start"),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutySchedule_Multi(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -816,6 +1008,26 @@ func testAccExternallyDestroySchedule(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccExternallyDestroyScheduleTeam(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Team ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		_, err := client.Teams.Delete(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckPagerDutyScheduleConfig(username, email, schedule, location, start, rotationVirtualStart string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -1025,6 +1237,37 @@ resource "pagerduty_schedule" "foo" {
 `, username, email, schedule, location, start, rotationVirtualStart)
 }
 
+func testAccCheckPagerDutyScheduleConfigWeeklyRotation(username, email, schedule, location, start, rotationVirtualStart string, turnLength int) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_schedule" "foo" {
+  name = "%s"
+
+  time_zone   = "%s"
+  description = "foo"
+
+  layer {
+    name                         = "foo"
+    start                        = "%s"
+    rotation_virtual_start       = "%s"
+    rotation_turn_length_seconds = %d
+    users                        = [pagerduty_user.foo.id]
+
+    restriction {
+      type              = "weekly_restriction"
+      start_time_of_day = "08:00:00"
+      start_day_of_week = 1
+      duration_seconds  = 32101
+    }
+  }
+}
+`, username, email, schedule, location, start, rotationVirtualStart, turnLength)
+}
+
 func testAccCheckPagerDutyScheduleConfigWeekUpdated(username, email, schedule, location, start, rotationVirtualStart string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -1055,6 +1298,31 @@ resource "pagerduty_schedule" "foo" {
 `, username, email, schedule, location, start, rotationVirtualStart)
 }
 
+func testAccCheckPagerDutyScheduleConfigEndingLayer(username, email, schedule, location, start, rotationVirtualStart, end string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_schedule" "foo" {
+  name = "%s"
+
+  time_zone   = "%s"
+  description = "foo"
+
+  layer {
+    name                         = "foo"
+    start                        = "%s"
+    end                          = "%s"
+    rotation_virtual_start       = "%s"
+    rotation_turn_length_seconds = 86400
+    users                        = [pagerduty_user.foo.id]
+  }
+}
+`, username, email, schedule, location, start, end, rotationVirtualStart)
+}
+
 func testAccCheckPagerDutyScheduleConfigMulti(username, email, schedule, location, start, rotationVirtualStart, end string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
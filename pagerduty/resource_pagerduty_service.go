@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -18,7 +21,7 @@ import (
 )
 
 func resourcePagerDutyService() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create:        resourcePagerDutyServiceCreate,
 		Read:          resourcePagerDutyServiceRead,
 		Update:        resourcePagerDutyServiceUpdate,
@@ -27,6 +30,7 @@ func resourcePagerDutyService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		SchemaVersion: 1,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:             schema.TypeString,
@@ -37,6 +41,10 @@ func resourcePagerDutyService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"self": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -122,6 +130,10 @@ func resourcePagerDutyService() *schema.Resource {
 										Computed:         true,
 										ValidateDiagFunc: validateTimeWindow,
 									},
+									"use_recommended_timeout": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
 								},
 							},
 						},
@@ -166,6 +178,11 @@ func resourcePagerDutyService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"acknowledgement_timeout": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -240,6 +257,9 @@ func resourcePagerDutyService() *schema.Resource {
 						"type": {
 							Type:     schema.TypeString,
 							Optional: true,
+							ValidateDiagFunc: validateValueDiagFunc([]string{
+								"fixed_time_per_day",
+							}),
 						},
 						"time_zone": {
 							Type:             schema.TypeString,
@@ -247,18 +267,24 @@ func resourcePagerDutyService() *schema.Resource {
 							ValidateDiagFunc: util.ValidateTZValueDiagFunc,
 						},
 						"start_time": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(supportHoursTimeRegexp, "must be a time of the day in the HH:MM:SS format"),
 						},
 						"end_time": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(supportHoursTimeRegexp, "must be a time of the day in the HH:MM:SS format"),
 						},
 						"days_of_week": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 7,
-							Elem:     &schema.Schema{Type: schema.TypeInt},
+							Type:             schema.TypeList,
+							Optional:         true,
+							MaxItems:         7,
+							DiffSuppressFunc: suppressSupportHoursDaysOfWeekDiff,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(1, 7),
+							},
 						},
 					},
 				},
@@ -307,8 +333,83 @@ func resourcePagerDutyService() *schema.Resource {
 			},
 		},
 	}
+
+	// Version 0 of the schema only differs in how the alert grouping is
+	// stored, so it shares the schema of the current version.
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    r.CoreConfigSchema().ImpliedType(),
+			Upgrade: resourcePagerDutyServiceStateUpgradeV0,
+		},
+	}
+
+	return r
+}
+
+// resourcePagerDutyServiceStateUpgradeV0 translates the deprecated
+// alert_grouping and alert_grouping_timeout attributes of the state into the
+// alert_grouping_parameters block which replaces them, so the services of the
+// configurations migrating to alert_grouping_parameters don't show a diff.
+func resourcePagerDutyServiceStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if agp, ok := rawState["alert_grouping_parameters"].([]interface{}); ok && len(agp) > 0 {
+		return rawState, nil
+	}
+
+	alertGrouping, _ := rawState["alert_grouping"].(string)
+	config := map[string]interface{}{
+		"aggregate":   "",
+		"fields":      []interface{}{},
+		"timeout":     0,
+		"time_window": 0,
+	}
+
+	switch alertGrouping {
+	case "time":
+		if timeout, ok := rawState["alert_grouping_timeout"].(string); ok && timeout != "null" && timeout != "" {
+			val, err := strconv.Atoi(timeout)
+			if err != nil {
+				return nil, fmt.Errorf("invalid alert_grouping_timeout %q in state: %w", timeout, err)
+			}
+			config["timeout"] = val
+		}
+	case "intelligent":
+	default:
+		// "rules" has no equivalent in alert_grouping_parameters.
+		return rawState, nil
+	}
+
+	rawState["alert_grouping_parameters"] = []interface{}{
+		map[string]interface{}{
+			"type":   alertGrouping,
+			"config": []interface{}{config},
+		},
+	}
+	log.Printf("[INFO] Upgraded alert_grouping %q of PagerDuty service %v to alert_grouping_parameters", alertGrouping, rawState["id"])
+
+	return rawState, nil
 }
 
+// isAlertGroupingParametersConfigured reports whether the alert grouping of
+// the service is configured with alert_grouping_parameters rather than with
+// the deprecated alert_grouping and alert_grouping_timeout attributes. All of
+// them are computed, so the state holds values for the set which isn't in the
+// configuration too.
+func isAlertGroupingParametersConfigured(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		_, ok := d.GetOk("alert_grouping_parameters")
+		return ok
+	}
+
+	agp := rawConfig.GetAttr("alert_grouping_parameters")
+	return !agp.IsNull() && (!agp.IsKnown() || agp.LengthInt() > 0)
+}
+
+// supportHoursTimeRegexp matches the HH:MM:SS times of the day used to define
+// service support hours.
+var supportHoursTimeRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$`)
+
 func customizePagerDutyServiceDiff(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
 	in := diff.Get("incident_urgency_rule.#").(int)
 	for i := 0; i <= in; i++ {
@@ -323,6 +424,45 @@ func customizePagerDutyServiceDiff(context context.Context, diff *schema.Resourc
 		if diff.Get("support_hours.#").(int) != 1 {
 			return fmt.Errorf("when using type = use_support_hours in incident_urgency_rule you must specify exactly one (otherwise optional) support_hours block")
 		}
+		if diff.Get("incident_urgency_rule.0.during_support_hours.#").(int) != 1 || diff.Get("incident_urgency_rule.0.outside_support_hours.#").(int) != 1 {
+			return fmt.Errorf("when using type = use_support_hours in incident_urgency_rule you must specify both during_support_hours and outside_support_hours blocks")
+		}
+	}
+	if incidentUrgencyRuleType == "constant" {
+		if diff.Get("incident_urgency_rule.0.during_support_hours.#").(int) > 0 || diff.Get("incident_urgency_rule.0.outside_support_hours.#").(int) > 0 {
+			return fmt.Errorf("during_support_hours and outside_support_hours can only be set for a use_support_hours incident urgency rule type")
+		}
+	}
+	for _, rule := range []string{"incident_urgency_rule.0", "incident_urgency_rule.0.during_support_hours.0", "incident_urgency_rule.0.outside_support_hours.0"} {
+		if err := validateConstantIncidentUrgency(diff, rule); err != nil {
+			return err
+		}
+	}
+
+	// Services creating incidents only don't group alerts, so the API ignores
+	// their alert grouping. The diff of alert_creation is suppressed, hence
+	// the configuration is checked instead.
+	if rawConfig := diff.GetRawConfig(); !rawConfig.IsNull() && rawConfig.IsKnown() {
+		alertCreation := rawConfig.GetAttr("alert_creation")
+		if alertCreation.IsKnown() && !alertCreation.IsNull() && alertCreation.AsString() == "create_incidents" {
+			for _, attr := range []string{"alert_grouping", "alert_grouping_timeout", "alert_grouping_parameters"} {
+				v := rawConfig.GetAttr(attr)
+				if v.IsNull() || (v.IsKnown() && v.Type().IsListType() && v.LengthInt() == 0) {
+					continue
+				}
+				return fmt.Errorf("%s can't be set when alert_creation is \"create_incidents\", services creating incidents only don't group alerts", attr)
+			}
+
+			// Notifications are auto-paused for transient alerts, which
+			// these services don't create.
+			apnp := rawConfig.GetAttr("auto_pause_notifications_parameters")
+			if apnp.IsKnown() && !apnp.IsNull() && apnp.LengthInt() > 0 {
+				enabled := apnp.Index(cty.NumberIntVal(0)).GetAttr("enabled")
+				if enabled.IsKnown() && !enabled.IsNull() && enabled.True() {
+					return fmt.Errorf("auto_pause_notifications_parameters.enabled can't be true when alert_creation is \"create_incidents\", notifications are only auto-paused for the alerts of services creating alerts and incidents")
+				}
+			}
+		}
 	}
 
 	// Due to alert_grouping_parameters.type = null is a valid configuration
@@ -346,16 +486,25 @@ func customizePagerDutyServiceDiff(context context.Context, diff *schema.Resourc
 		aggregateVal := diff.Get(agppath + "aggregate").(string)
 		fieldsVal := diff.Get(agppath + "fields").([]interface{})
 		timeWindowVal := diff.Get(agppath + "time_window").(int)
+		useRecommendedTimeoutVal := diff.Get(agppath + "use_recommended_timeout").(bool)
 		hasChangeAgpType := diff.HasChange("alert_grouping_parameters")
 
-		if agpType == "content_based" && (aggregateVal == "" || len(fieldsVal) == 0) {
-			return fmt.Errorf("When using Alert grouping parameters configuration of type \"content_based\" is in use, attributes \"aggregate\" and \"fields\" are required")
+		contentBasedKnown := diff.NewValueKnown(agppath+"aggregate") && diff.NewValueKnown(agppath+"fields")
+		if err := validateContentBasedAlertGroupingConfig(agpType, aggregateVal, fieldsVal, contentBasedKnown); err != nil {
+			return err
 		}
 		if timeWindowVal == 86400 && agpType != "content_based" {
 			return fmt.Errorf("Alert grouping parameters configuration attribute \"time_window\" with a value of 86400 is only supported by \"content-based\" type Alert Grouping")
 		}
 		if (aggregateVal != "" || len(fieldsVal) > 0) && (agpType != "" && hasChangeAgpType && agpType != "content_based") {
-			return fmt.Errorf("Alert grouping parameters configuration attributes \"aggregate\" and \"fields\" are only supported by \"content_based\" type Alert Grouping")
+			switch {
+			case aggregateVal != "" && len(fieldsVal) > 0:
+				return fmt.Errorf("Alert grouping parameters configuration attributes \"aggregate\" and \"fields\" are only supported by \"content_based\" type Alert Grouping")
+			case aggregateVal != "":
+				return fmt.Errorf("Alert grouping parameters configuration attribute \"aggregate\" is only supported by \"content_based\" type Alert Grouping")
+			default:
+				return fmt.Errorf("Alert grouping parameters configuration attribute \"fields\" is only supported by \"content_based\" type Alert Grouping")
+			}
 		}
 		if timeoutVal > 0 && (agpType != "" && hasChangeAgpType && agpType != "time") {
 			return fmt.Errorf("Alert grouping parameters configuration attribute \"timeout\" is only supported by \"time\" type Alert Grouping")
@@ -363,11 +512,76 @@ func customizePagerDutyServiceDiff(context context.Context, diff *schema.Resourc
 		if (timeWindowVal > 300) && (agpType != "" && hasChangeAgpType && (agpType != "intelligent" && agpType != "content_based")) {
 			return fmt.Errorf("Alert grouping parameters configuration attribute \"time_window\" is only supported by \"intelligent\" and \"content-based\" type Alert Grouping")
 		}
+		if useRecommendedTimeoutVal && agpType != "intelligent" {
+			return fmt.Errorf("Alert grouping parameters configuration attribute \"use_recommended_timeout\" is only supported by \"intelligent\" type Alert Grouping")
+		}
+		if useRecommendedTimeoutVal && isAlertGroupingTimeWindowConfigured(diff) {
+			return fmt.Errorf("Alert grouping parameters configuration attributes \"use_recommended_timeout\" and \"time_window\" can't be set together, the recommended time window replaces the configured one")
+		}
+	}
+
+	if err := planServiceResponsePlayRemoval(diff); err != nil {
+		return err
+	}
+
+	return validateServiceResponsePlay(diff, i)
+}
+
+// validateContentBasedAlertGroupingConfig checks that the content based alert
+// grouping has the aggregate and the fields required to group the alerts,
+// the check is skipped while they aren't known.
+func validateContentBasedAlertGroupingConfig(agpType, aggregate string, fields []interface{}, known bool) error {
+	if agpType != "content_based" || !known {
+		return nil
+	}
+
+	switch {
+	case aggregate == "" && len(fields) == 0:
+		return fmt.Errorf("When Alert grouping parameters configuration of type \"content_based\" is in use, attributes \"aggregate\" and \"fields\" are required")
+	case aggregate == "":
+		return fmt.Errorf("When Alert grouping parameters configuration of type \"content_based\" is in use, attribute \"aggregate\" is required, set it to \"all\" or \"any\"")
+	case len(fields) == 0:
+		return fmt.Errorf("When Alert grouping parameters configuration of type \"content_based\" is in use, attribute \"fields\" requires at least one field to group the alerts by")
+	}
+
+	for i, f := range fields {
+		if f == nil || strings.TrimSpace(f.(string)) == "" {
+			return fmt.Errorf("Alert grouping parameters configuration attribute \"fields\" can't have empty field names, field %d is empty", i)
+		}
 	}
 
 	return nil
 }
 
+// isAlertGroupingTimeWindowConfigured reports whether the configuration sets
+// the time_window of alert_grouping_parameters, it's computed so the diff
+// holds the value of the state when it isn't set.
+func isAlertGroupingTimeWindowConfigured(diff *schema.ResourceDiff) bool {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+
+	v := rawConfig.GetAttr("alert_grouping_parameters")
+	for _, attr := range []string{"config", "time_window"} {
+		if v.IsNull() || !v.IsKnown() {
+			return false
+		}
+		if v.Type().IsListType() {
+			if v.LengthInt() == 0 {
+				return false
+			}
+			v = v.Index(cty.NumberIntVal(0))
+		}
+		if v.IsNull() || !v.IsKnown() {
+			return false
+		}
+		v = v.GetAttr(attr)
+	}
+
+	return !v.IsNull()
+}
+
 func validateTimeWindow(v interface{}, p cty.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -415,24 +629,28 @@ func buildServiceStruct(d *schema.ResourceData) (*pagerduty.Service, error) {
 		service.AlertCreation = attr.(string)
 	}
 
-	if attr, ok := d.GetOk("alert_grouping"); ok {
-		ag := attr.(string)
-		service.AlertGrouping = &ag
-	}
-
-	if attr, ok := d.GetOk("alert_grouping_parameters"); ok {
-		service.AlertGroupingParameters = expandAlertGroupingParameters(attr)
+	// Only one of the two sets of alert grouping attributes is sent, the
+	// values left in the state by the other set would conflict with it.
+	if isAlertGroupingParametersConfigured(d) {
+		if attr, ok := d.GetOk("alert_grouping_parameters"); ok {
+			service.AlertGroupingParameters = expandAlertGroupingParameters(attr)
+		}
 	} else {
 		// Clear AlertGroupingParameters as it takes precedence over AlertGrouping and AlertGroupingTimeout which are apparently deprecated (that's not explicitly documented in the API)
 		service.AlertGroupingParameters = nil
-	}
 
-	if attr, ok := d.GetOk("alert_grouping_timeout"); ok {
-		if attr.(string) != "null" {
-			if val, err := strconv.Atoi(attr.(string)); err == nil {
-				service.AlertGroupingTimeout = &val
-			} else {
-				return nil, err
+		if attr, ok := d.GetOk("alert_grouping"); ok {
+			ag := attr.(string)
+			service.AlertGrouping = &ag
+		}
+
+		if attr, ok := d.GetOk("alert_grouping_timeout"); ok {
+			if attr.(string) != "null" {
+				if val, err := strconv.Atoi(attr.(string)); err == nil {
+					service.AlertGroupingTimeout = &val
+				} else {
+					return nil, err
+				}
 			}
 		}
 	}
@@ -462,6 +680,15 @@ func buildServiceStruct(d *schema.ResourceData) (*pagerduty.Service, error) {
 		service.SupportHours = expandSupportHours(attr)
 	}
 
+	// Only the "active" and "disabled" statuses can be set, the remaining ones
+	// are computed by PagerDuty from the open incidents of the service.
+	if d.HasChange("disabled") {
+		service.Status = "active"
+		if d.Get("disabled").(bool) {
+			service.Status = "disabled"
+		}
+	}
+
 	if attr, ok := d.GetOk("response_play"); ok {
 		if attr.(string) != "null" {
 			service.ResponsePlay = &pagerduty.ResponsePlayReference{
@@ -519,7 +746,7 @@ func resourcePagerDutyServiceCreate(d *schema.ResourceData, meta interface{}) er
 
 	service, _, err = client.Services.Create(service)
 	if err != nil {
-		return err
+		return handleServiceStatusError(err, d)
 	}
 
 	d.SetId(service.ID)
@@ -547,6 +774,9 @@ func resourcePagerDutyServiceUpdate(d *schema.ResourceData, meta interface{}) er
 
 	updatedService, _, err := client.Services.Update(d.Id(), service)
 	if err != nil {
+		if d.HasChange("disabled") && isServiceStatusNotAllowedError(err) {
+			return handleServiceStatusError(err, d)
+		}
 		return handleNotFoundError(err, d)
 	}
 
@@ -572,11 +802,26 @@ func resourcePagerDutyServiceDelete(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+// isServiceStatusNotAllowedError reports whether err is the API refusing to
+// change the status of a service because of the abilities of the account.
+func isServiceStatusNotAllowedError(err error) bool {
+	return isErrCode(err, http.StatusPaymentRequired) || isErrCode(err, http.StatusForbidden)
+}
+
+func handleServiceStatusError(err error, d *schema.ResourceData) error {
+	if d.Get("disabled").(bool) && isServiceStatusNotAllowedError(err) {
+		return fmt.Errorf("Error disabling PagerDuty service %q: the abilities of the account don't allow to disable services, remove the \"disabled\" attribute or upgrade the account plan: %w", d.Get("name").(string), err)
+	}
+	return err
+}
+
 func flattenService(d *schema.ResourceData, service *pagerduty.Service) error {
 	d.Set("name", service.Name)
 	d.Set("type", service.Type)
 	d.Set("html_url", service.HTMLURL)
+	d.Set("self", service.Self)
 	d.Set("status", service.Status)
+	d.Set("disabled", service.Status == "disabled")
 	d.Set("created_at", service.CreatedAt)
 	d.Set("escalation_policy", service.EscalationPolicy.ID)
 	d.Set("description", service.Description)
@@ -601,10 +846,14 @@ func flattenService(d *schema.ResourceData, service *pagerduty.Service) error {
 		d.Set("alert_grouping_timeout", strconv.Itoa(*service.AlertGroupingTimeout))
 	}
 
-	_, hasGrouping := d.GetOk("alert_grouping")
+	// alert_grouping is computed from the response too, so it can't tell
+	// whether the alert grouping is managed with the deprecated attributes.
 	_, hasGroupingParams := d.GetOk("alert_grouping_parameters")
-	if service.AlertGroupingParameters != nil && (!hasGrouping && hasGroupingParams) {
-		if err := d.Set("alert_grouping_parameters", flattenAlertGroupingParameters(service.AlertGroupingParameters)); err != nil {
+	if service.AlertGroupingParameters != nil && hasGroupingParams {
+		// The API responds with the time window it recommends, which tells
+		// nothing about whether it was asked for, so the flag is kept as is.
+		useRecommendedTimeout := d.Get("alert_grouping_parameters.0.config.0.use_recommended_timeout").(bool)
+		if err := d.Set("alert_grouping_parameters", flattenAlertGroupingParameters(service.AlertGroupingParameters, useRecommendedTimeout)); err != nil {
 			return err
 		}
 	}
@@ -634,6 +883,8 @@ func flattenService(d *schema.ResourceData, service *pagerduty.Service) error {
 	}
 	if service.ResponsePlay != nil {
 		d.Set("response_play", service.ResponsePlay.ID)
+	} else {
+		d.Set("response_play", "")
 	}
 	return nil
 }
@@ -696,7 +947,10 @@ func expandAlertGroupingConfig(groupingType string, v interface{}) *pagerduty.Al
 		}
 	}
 
-	if groupingType == "intelligent" || groupingType == "content_based" {
+	// Leaving the time window out of the request makes the API use the one it
+	// recommends for the service.
+	useRecommendedTimeout, _ := config["use_recommended_timeout"].(bool)
+	if (groupingType == "intelligent" && !useRecommendedTimeout) || groupingType == "content_based" {
 		if val, ok := config["time_window"]; ok {
 			to := val.(int)
 			alertGroupingConfig.TimeWindow = &to
@@ -719,13 +973,13 @@ func expandAlertGroupingConfig(groupingType string, v interface{}) *pagerduty.Al
 	return alertGroupingConfig
 }
 
-func flattenAlertGroupingParameters(v *pagerduty.AlertGroupingParameters) interface{} {
+func flattenAlertGroupingParameters(v *pagerduty.AlertGroupingParameters, useRecommendedTimeout bool) interface{} {
 	alertGroupingParameters := map[string]interface{}{}
 
 	if v.Config == nil && v.Type == nil {
 		return []interface{}{alertGroupingParameters}
 	} else {
-		alertGroupingParameters = map[string]interface{}{"type": "", "config": []map[string]interface{}{{"aggregate": nil, "fields": nil, "timeout": nil, "time_window": nil}}}
+		alertGroupingParameters = map[string]interface{}{"type": "", "config": []map[string]interface{}{{"aggregate": nil, "fields": nil, "timeout": nil, "time_window": nil, "use_recommended_timeout": useRecommendedTimeout}}}
 	}
 
 	if v.Type != nil {
@@ -733,18 +987,19 @@ func flattenAlertGroupingParameters(v *pagerduty.AlertGroupingParameters) interf
 	}
 
 	if v.Config != nil {
-		alertGroupingParameters["config"] = flattenAlertGroupingConfig(v.Config)
+		alertGroupingParameters["config"] = flattenAlertGroupingConfig(v.Config, useRecommendedTimeout)
 	}
 
 	return []interface{}{alertGroupingParameters}
 }
 
-func flattenAlertGroupingConfig(v *pagerduty.AlertGroupingConfig) interface{} {
+func flattenAlertGroupingConfig(v *pagerduty.AlertGroupingConfig, useRecommendedTimeout bool) interface{} {
 	alertGroupingConfig := map[string]interface{}{
-		"aggregate":   v.Aggregate,
-		"fields":      v.Fields,
-		"timeout":     v.Timeout,
-		"time_window": v.TimeWindow,
+		"aggregate":               v.Aggregate,
+		"fields":                  v.Fields,
+		"timeout":                 v.Timeout,
+		"time_window":             v.TimeWindow,
+		"use_recommended_timeout": useRecommendedTimeout,
 	}
 
 	return []interface{}{alertGroupingConfig}
@@ -761,6 +1016,31 @@ func flattenAutoPauseNotificationsParameters(v *pagerduty.AutoPauseNotifications
 	return []interface{}{autoPauseNotificationsParameters}
 }
 
+// constantIncidentUrgencies are the valid urgencies of the incident urgency
+// rules of type constant.
+var constantIncidentUrgencies = []string{"high", "low", "severity_based"}
+
+// validateConstantIncidentUrgency checks the incident urgency rule at the path
+// sets a valid urgency when its type is constant, the API falls back to a
+// default urgency otherwise.
+func validateConstantIncidentUrgency(diff *schema.ResourceDiff, rule string) error {
+	if diff.Get(rule+".type").(string) != "constant" || !diff.NewValueKnown(rule+".urgency") {
+		return nil
+	}
+
+	urgency := diff.Get(rule + ".urgency").(string)
+	if urgency == "" {
+		return fmt.Errorf("urgency must be set for the constant incident urgency rule %s", rule)
+	}
+	for _, u := range constantIncidentUrgencies {
+		if urgency == u {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%q is an invalid urgency of the constant incident urgency rule %s. Must be one of %#v", urgency, rule, constantIncidentUrgencies)
+}
+
 func expandIncidentUrgencyRule(v interface{}) *pagerduty.IncidentUrgencyRule {
 	incidentUrgencyRule := &pagerduty.IncidentUrgencyRule{}
 	riur := make(map[string]interface{})
@@ -893,20 +1173,65 @@ func flattenSupportHours(v *pagerduty.SupportHours) []interface{} {
 	}
 
 	if v.StartTime != "" {
-		supportHours["start_time"] = v.StartTime
+		supportHours["start_time"] = canonicalSupportHoursTime(v.StartTime)
 	}
 
 	if v.EndTime != "" {
-		supportHours["end_time"] = v.EndTime
+		supportHours["end_time"] = canonicalSupportHoursTime(v.EndTime)
 	}
 
 	if len(v.DaysOfWeek) > 0 {
-		supportHours["days_of_week"] = v.DaysOfWeek
+		daysOfWeek := append([]int(nil), v.DaysOfWeek...)
+		sort.Ints(daysOfWeek)
+		supportHours["days_of_week"] = daysOfWeek
 	}
 
 	return []interface{}{supportHours}
 }
 
+// canonicalSupportHoursTime sets the times of the support hours in the
+// HH:MM:SS format of the configuration, the API can leave out the seconds or
+// the leading zero of the hour.
+func canonicalSupportHoursTime(v string) string {
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t.Format("15:04:05")
+		}
+	}
+	return v
+}
+
+// suppressSupportHoursDaysOfWeekDiff ignores the order of the days of the
+// support hours, which are read sorted.
+func suppressSupportHoursDaysOfWeekDiff(k, old, new string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("support_hours.0.days_of_week")
+	return sameSupportHoursDaysOfWeek(o.([]interface{}), n.([]interface{}))
+}
+
+func sameSupportHoursDaysOfWeek(a, b []interface{}) bool {
+	days := func(l []interface{}) []int {
+		var result []int
+		for _, v := range l {
+			if day, ok := v.(int); ok {
+				result = append(result, day)
+			}
+		}
+		sort.Ints(result)
+		return result
+	}
+
+	x, y := days(a), days(b)
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func expandScheduledActions(v interface{}) []*pagerduty.ScheduledAction {
 	var scheduledActions []*pagerduty.ScheduledAction
 
@@ -338,6 +338,9 @@ func resourcePagerDutyServiceEventRuleCreate(d *schema.ResourceData, meta interf
 	}
 
 	rule := buildServiceEventRuleStruct(d)
+	if err := resolveRuleActionsPriority(meta.(*Config), rule.Actions); err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Creating PagerDuty service event rule for service: %s", rule.Service.ID)
 
@@ -389,6 +392,7 @@ func resourcePagerDutyServiceEventRuleRead(d *schema.ResourceData, meta interfac
 				d.Set("conditions", flattenConditions(rule.Conditions))
 			}
 			if rule.Actions != nil {
+				preserveRuleActionsPriorityRef(meta.(*Config), d, rule.Actions)
 				d.Set("actions", flattenActions(rule.Actions))
 			}
 			if rule.TimeFrame != nil {
@@ -412,6 +416,9 @@ func resourcePagerDutyServiceEventRuleUpdate(d *schema.ResourceData, meta interf
 	}
 
 	rule := buildServiceEventRuleStruct(d)
+	if err := resolveRuleActionsPriority(meta.(*Config), rule.Actions); err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Updating PagerDuty service event rule: %s", d.Id())
 	serviceID := d.Get("service").(string)
@@ -40,12 +40,17 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"adopt_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"type": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
 				Computed:      true,
-				ConflictsWith: []string{"vendor"},
+				ConflictsWith: []string{"vendor", "events_api_version"},
 				ValidateDiagFunc: validateValueDiagFunc([]string{
 					"aws_cloudwatch_inbound_integration",
 					"cloudkick_inbound_integration",
@@ -63,13 +68,25 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 				Type:          schema.TypeString,
 				ForceNew:      true,
 				Optional:      true,
-				ConflictsWith: []string{"type"},
+				ConflictsWith: []string{"type", "events_api_version"},
 				Computed:      true,
 			},
+			"events_api_version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"type", "vendor"},
+				ValidateDiagFunc: validateValueDiagFunc([]string{
+					"v1",
+					"v2",
+				}),
+			},
 			"integration_key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
 				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
 					v, ok := i.(string)
 					if !ok {
@@ -395,6 +412,25 @@ func customizeServiceIntegrationDiff() schema.CustomizeDiffFunc {
 	}
 }
 
+// serviceIntegrationTypeOfEventsAPIVersion maps the versions of the Events API
+// to the type of the generic integrations receiving their events.
+var serviceIntegrationTypeOfEventsAPIVersion = map[string]string{
+	"v1": "generic_events_api_inbound_integration",
+	"v2": "events_api_v2_inbound_integration",
+}
+
+// eventsAPIVersionOfServiceIntegrationType returns the version of the Events
+// API of an integration type, or an empty string for the types which aren't
+// receiving events through it.
+func eventsAPIVersionOfServiceIntegrationType(t string) string {
+	for version, integrationType := range serviceIntegrationTypeOfEventsAPIVersion {
+		if integrationType == t {
+			return version
+		}
+	}
+	return ""
+}
+
 func buildServiceIntegrationStruct(d *schema.ResourceData) (*pagerduty.Integration, error) {
 	serviceIntegration := &pagerduty.Integration{
 		Name: d.Get("name").(string),
@@ -417,6 +453,10 @@ func buildServiceIntegrationStruct(d *schema.ResourceData) (*pagerduty.Integrati
 		serviceIntegration.Type = attr.(string)
 	}
 
+	if attr, ok := d.GetOk("events_api_version"); ok {
+		serviceIntegration.Type = serviceIntegrationTypeOfEventsAPIVersion[attr.(string)]
+	}
+
 	if attr, ok := d.GetOk("vendor"); ok {
 		serviceIntegration.Vendor = &pagerduty.VendorReference{
 			ID:   attr.(string),
@@ -674,6 +714,10 @@ func fetchPagerDutyServiceIntegration(d *schema.ResourceData, meta interface{},
 			return retry.RetryableError(err)
 		}
 
+		if err := d.Set("events_api_version", eventsAPIVersionOfServiceIntegrationType(serviceIntegration.Type)); err != nil {
+			return retry.RetryableError(err)
+		}
+
 		if serviceIntegration.Service != nil {
 			if err := d.Set("service", serviceIntegration.Service.ID); err != nil {
 				return retry.RetryableError(err)
@@ -753,6 +797,21 @@ func resourcePagerDutyServiceIntegrationCreate(d *schema.ResourceData, meta inte
 
 	service := d.Get("service").(string)
 
+	if d.Get("adopt_existing").(bool) && serviceIntegration.Name != "" {
+		id, err := findServiceIntegrationByName(client, service, serviceIntegration.Name)
+		if err != nil {
+			return err
+		}
+		if id != "" {
+			if err := checkAdoptedServiceIntegration(client, service, id, serviceIntegration); err != nil {
+				return err
+			}
+			log.Printf("[INFO] Adopting PagerDuty service integration %s named %s", id, serviceIntegration.Name)
+			d.SetId(id)
+			return resourcePagerDutyServiceIntegrationUpdate(d, meta)
+		}
+	}
+
 	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
 		if serviceIntegration, _, err := client.Services.CreateIntegration(service, serviceIntegration); err != nil {
 			if isErrCode(err, 400) {
@@ -762,6 +821,12 @@ func resourcePagerDutyServiceIntegrationCreate(d *schema.ResourceData, meta inte
 			return retry.NonRetryableError(err)
 		} else if serviceIntegration != nil {
 			d.SetId(serviceIntegration.ID)
+			// Email integrations created without an integration_email get an
+			// address generated by PagerDuty, keep it in case the read below
+			// doesn't return it yet.
+			if serviceIntegration.IntegrationEmail != "" {
+				d.Set("integration_email", serviceIntegration.IntegrationEmail)
+			}
 		}
 		return nil
 	})
@@ -773,6 +838,82 @@ func resourcePagerDutyServiceIntegrationCreate(d *schema.ResourceData, meta inte
 	return fetchPagerDutyServiceIntegration(d, meta, genError)
 }
 
+// findServiceIntegrationByName returns the ID of the integration of the
+// service with the given name, or an empty ID when the service has none.
+func findServiceIntegrationByName(client *pagerduty.Client, service, name string) (string, error) {
+	var found []string
+	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+		s, _, err := client.Services.Get(service, &pagerduty.GetServiceOptions{})
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(err)
+			}
+
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+
+		found = nil
+		for _, i := range s.Integrations {
+			if i != nil && i.Summary == name {
+				found = append(found, i.ID)
+			}
+		}
+		return nil
+	})
+	if retryErr != nil {
+		return "", fmt.Errorf("error looking for an integration named %q on service %s: %w", name, service, retryErr)
+	}
+
+	switch len(found) {
+	case 0:
+		return "", nil
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("service %s has %d integrations named %q (%s), adopt_existing can't pick one of them, import the one to manage instead", service, len(found), name, strings.Join(found, ", "))
+	}
+}
+
+// checkAdoptedServiceIntegration refuses to adopt the integration id when its
+// type or vendor isn't the one configured, as both can't be updated and the
+// adopted integration would be replaced by the next plan.
+func checkAdoptedServiceIntegration(client *pagerduty.Client, service, id string, configured *pagerduty.Integration) error {
+	var existing *pagerduty.Integration
+	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+		i, _, err := client.Services.GetIntegration(service, id, &pagerduty.GetIntegrationOptions{})
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(err)
+			}
+
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+		existing = i
+		return nil
+	})
+	if retryErr != nil {
+		return fmt.Errorf("error reading the integration %s to adopt on service %s: %w", id, service, retryErr)
+	}
+
+	if configured.Vendor != nil {
+		vendor := ""
+		if existing.Vendor != nil {
+			vendor = existing.Vendor.ID
+		}
+		if vendor != configured.Vendor.ID {
+			return fmt.Errorf("the integration %s named %q on service %s has the vendor %q instead of %q, adopt_existing can't adopt it, rename one of them or import it instead", id, configured.Name, service, vendor, configured.Vendor.ID)
+		}
+		return nil
+	}
+	if configured.Type != "" && existing.Type != configured.Type {
+		return fmt.Errorf("the integration %s named %q on service %s has the type %q instead of %q, adopt_existing can't adopt it, rename one of them or import it instead", id, configured.Name, service, existing.Type, configured.Type)
+	}
+
+	return nil
+}
+
 func resourcePagerDutyServiceIntegrationRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Reading PagerDuty service integration %s", d.Id())
 	return fetchPagerDutyServiceIntegration(d, meta, handleNotFoundError)
@@ -797,7 +938,7 @@ func resourcePagerDutyServiceIntegrationUpdate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	return nil
+	return fetchPagerDutyServiceIntegration(d, meta, genError)
 }
 
 func resourcePagerDutyServiceIntegrationDelete(d *schema.ResourceData, meta interface{}) error {
@@ -840,6 +981,7 @@ func resourcePagerDutyServiceIntegrationImport(d *schema.ResourceData, meta inte
 	// These are set because an import also calls Read behind the scenes
 	d.SetId(id)
 	d.Set("service", sid)
+	d.Set("adopt_existing", false)
 
 	return []*schema.ResourceData{d}, nil
 }
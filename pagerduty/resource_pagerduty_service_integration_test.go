@@ -1,14 +1,21 @@
 package pagerduty
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
@@ -55,6 +62,37 @@ func TestAccPagerDutyServiceIntegration_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyServiceIntegration_SensitiveIntegrationKey(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	serviceIntegration := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationConfig(username, email, escalationPolicy, service, serviceIntegration),
+				// The values marked as sensitive in the plan are the ones
+				// redacted in its formatted output.
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectSensitiveValue("pagerduty_service_integration.foo", tfjsonpath.New("integration_key")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_service_integration.foo", "integration_key"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyServiceIntegrationGeneric_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -114,6 +152,178 @@ func TestAccPagerDutyServiceIntegrationGeneric_Basic(t *testing.T) {
 		},
 	})
 }
+func TestAccPagerDutyServiceIntegration_EventsAPIVersion(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	serviceIntegration := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyServiceIntegrationEventsAPIVersionConfig(username, email, escalationPolicy, service, serviceIntegration, `events_api_version = "v3"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid value`),
+			},
+			{
+				Config:      testAccCheckPagerDutyServiceIntegrationEventsAPIVersionConfig(username, email, escalationPolicy, service, serviceIntegration, "events_api_version = \"v2\"\n  type               = \"events_api_v2_inbound_integration\""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"events_api_version": conflicts with type`),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationEventsAPIVersionConfig(username, email, escalationPolicy, service, serviceIntegration, `events_api_version = "v2"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "events_api_version", "v2"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "type", "events_api_v2_inbound_integration"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationEventsAPIVersionConfig(username, email, escalationPolicy, service, serviceIntegration, `events_api_version = "v1"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "events_api_version", "v1"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "type", "generic_events_api_inbound_integration"),
+				),
+			},
+		},
+	})
+}
+
+func TestEventsAPIVersionOfServiceIntegrationType(t *testing.T) {
+	cases := map[string]string{
+		"generic_events_api_inbound_integration": "v1",
+		"events_api_v2_inbound_integration":      "v2",
+		"generic_email_inbound_integration":      "",
+		"":                                       "",
+	}
+	for integrationType, expected := range cases {
+		if got := eventsAPIVersionOfServiceIntegrationType(integrationType); got != expected {
+			t.Errorf("expected the Events API version of %q to be %q, got %q", integrationType, expected, got)
+		}
+	}
+}
+
+func TestResourcePagerDutyServiceIntegrationCreate_AdoptExisting(t *testing.T) {
+	var mu sync.Mutex
+	integrations := map[string]*pagerduty.Integration{}
+	created, updated := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/services/PSVC123":
+			refs := []*pagerduty.IntegrationReference{}
+			for _, i := range integrations {
+				refs = append(refs, &pagerduty.IntegrationReference{ID: i.ID, Type: "generic_events_api_inbound_integration_reference", Summary: i.Name})
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"service": &pagerduty.Service{ID: "PSVC123", Integrations: refs}})
+		case r.Method == http.MethodPost && r.URL.Path == "/services/PSVC123/integrations":
+			var v pagerduty.IntegrationPayload
+			json.NewDecoder(r.Body).Decode(&v)
+			created++
+			v.Integration.ID = fmt.Sprintf("PINT%03d", created)
+			integrations[v.Integration.ID] = v.Integration
+			// The integration is created, but the response is lost, as when
+			// the connection drops.
+			if created == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(`{"error":{"code":2000,"message":"Internal Server Error"}}`))
+				return
+			}
+			json.NewEncoder(w).Encode(&pagerduty.IntegrationPayload{Integration: v.Integration})
+		case strings.HasPrefix(r.URL.Path, "/services/PSVC123/integrations/"):
+			i, ok := integrations[strings.TrimPrefix(r.URL.Path, "/services/PSVC123/integrations/")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+				return
+			}
+			if r.Method == http.MethodPut {
+				var v pagerduty.IntegrationPayload
+				json.NewDecoder(r.Body).Decode(&v)
+				updated++
+				i.Name = v.Integration.Name
+			}
+			i.Service = &pagerduty.ServiceReference{ID: "PSVC123", Type: "service_reference"}
+			json.NewEncoder(w).Encode(&pagerduty.IntegrationPayload{Integration: i})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":2100,"message":"Not Found"}}`))
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	createIntegration := func(name, integrationType string, adopt bool) (string, error) {
+		r := resourcePagerDutyServiceIntegration()
+		d := r.TestResourceData()
+		d.Set("name", name)
+		d.Set("service", "PSVC123")
+		d.Set("type", integrationType)
+		d.Set("adopt_existing", adopt)
+		err := r.Create(d, config)
+		return d.Id(), err
+	}
+	create := func(adopt bool) (string, error) {
+		return createIntegration("Datadog", "generic_events_api_inbound_integration", adopt)
+	}
+
+	if _, err := create(true); err == nil {
+		t.Fatal("expected the first create to fail")
+	}
+
+	id, err := create(true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "PINT001" {
+		t.Errorf("expected the integration created by the failed create to be adopted, got %q", id)
+	}
+	if created != 1 || updated != 1 {
+		t.Errorf("expected the retried create to update the integration instead of creating another one, got %d creates and %d updates", created, updated)
+	}
+
+	id, err = create(false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "PINT002" {
+		t.Errorf("expected another integration to be created without adopt_existing, got %q", id)
+	}
+
+	_, err = create(true)
+	if err == nil || !strings.Contains(err.Error(), `service PSVC123 has 2 integrations named "Datadog" (`) {
+		t.Errorf("expected several integrations of the same name to fail the adoption, got %v", err)
+	}
+
+	mu.Lock()
+	integrations["PINT100"] = &pagerduty.Integration{ID: "PINT100", Name: "Pingdom", Type: "generic_events_api_inbound_integration"}
+	mu.Unlock()
+	_, err = createIntegration("Pingdom", "pingdom_inbound_integration", true)
+	if err == nil || !strings.Contains(err.Error(), `has the type "generic_events_api_inbound_integration" instead of "pingdom_inbound_integration"`) {
+		t.Errorf("expected an integration of another type not to be adopted, got %v", err)
+	}
+	if updated != 1 {
+		t.Errorf("expected the integration of another type not to be updated, got %d updates", updated)
+	}
+}
+
 func TestAccPagerDutyServiceIntegrationEmail_Filters(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -406,6 +616,44 @@ func TestAccPagerDutyServiceIntegration_GenericEmailNoFilters(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyServiceIntegrationEmail_GeneratedAddress(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	serviceIntegration := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	serviceIntegrationUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationEmailVendorConfig(username, email, escalationPolicy, service, serviceIntegration),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "name", serviceIntegration),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_service_integration.foo", "integration_email"),
+					resource.TestMatchResourceAttr(
+						"pagerduty_service_integration.foo", "integration_email", regexp.MustCompile(`^[^@\s]+@[^@\s]+$`)),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationEmailVendorConfig(username, email, escalationPolicy, service, serviceIntegrationUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_integration.foo", "name", serviceIntegrationUpdated),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_service_integration.foo", "integration_email"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyServiceIntegrationDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -592,6 +840,50 @@ resource "pagerduty_service_integration" "foo" {
 `, username, email, escalationPolicy, service, serviceIntegration)
 }
 
+func testAccCheckPagerDutyServiceIntegrationEventsAPIVersionConfig(username, email, escalationPolicy, service, serviceIntegration, integrationType string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%s"
+  email       = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%s"
+  description = "foo"
+  num_loops   = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name                    = "%s"
+  description             = "foo"
+  auto_resolve_timeout    = 1800
+  acknowledgement_timeout = 1800
+  escalation_policy       = pagerduty_escalation_policy.foo.id
+  alert_creation          = "create_alerts_and_incidents"
+
+  incident_urgency_rule {
+    type = "constant"
+    urgency = "high"
+  }
+}
+
+resource "pagerduty_service_integration" "foo" {
+  name               = "%s"
+  service            = pagerduty_service.foo.id
+  %s
+}
+`, username, email, escalationPolicy, service, serviceIntegration, integrationType)
+}
+
 func testAccCheckPagerDutyServiceIntegrationGenericConfigUpdated(username, email, escalationPolicy, service, serviceIntegration string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -1125,3 +1417,50 @@ func testAccPreCheckServiceIntegrationGenericEmailNoFilters(t *testing.T) {
 		t.Skip("PAGERDUTY_ACC_SERVICE_INTEGRATION_GENERIC_EMAIL_NO_FILTERS not set. Skipping Service Integration related test")
 	}
 }
+
+func testAccCheckPagerDutyServiceIntegrationEmailVendorConfig(username, email, escalationPolicy, service, serviceIntegration string) string {
+	return fmt.Sprintf(`
+data "pagerduty_vendor" "email" {
+  name = "Email"
+}
+
+resource "pagerduty_user" "foo" {
+  name        = "%s"
+  email       = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%s"
+  description = "foo"
+  num_loops   = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name                    = "%s"
+  description             = "foo"
+  auto_resolve_timeout    = 1800
+  acknowledgement_timeout = 1800
+  escalation_policy       = pagerduty_escalation_policy.foo.id
+
+  incident_urgency_rule {
+    type = "constant"
+    urgency = "high"
+  }
+}
+
+resource "pagerduty_service_integration" "foo" {
+  name    = "%s"
+  service = pagerduty_service.foo.id
+  vendor  = data.pagerduty_vendor.email.id
+}
+`, username, email, escalationPolicy, service, serviceIntegration)
+}
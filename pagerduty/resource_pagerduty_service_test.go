@@ -1,12 +1,15 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
 
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -64,6 +67,12 @@ func TestAccPagerDutyService_Basic(t *testing.T) {
 				Config: testAccCheckPagerDutyServiceConfig(username, email, escalationPolicy, service),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_service.foo", "html_url"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_service.foo", "self"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_service.foo", "created_at"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_service.foo", "name", service),
 					resource.TestCheckResourceAttr(
@@ -211,7 +220,7 @@ func TestAccPagerDutyService_FormatValidation(t *testing.T) {
           `,
 				),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("When using Alert grouping parameters configuration of type \"content_based\" is in use, attributes \"aggregate\" and \"fields\" are required"),
+				ExpectError: regexp.MustCompile("When Alert grouping parameters configuration of type \"content_based\" is in use, attributes \"aggregate\" and \"fields\" are required"),
 			},
 			{
 				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
@@ -242,6 +251,49 @@ func TestAccPagerDutyService_FormatValidation(t *testing.T) {
 				PlanOnly:    true,
 				ExpectError: regexp.MustCompile("Alert grouping parameters configuration attributes \"aggregate\" and \"fields\" are only supported by \"content_based\" type Alert Grouping"),
 			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
+					`
+          alert_grouping_parameters {
+            type = "intelligent"
+            config {
+              fields = ["custom_details.source_id"]
+            }
+          }
+          `,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Alert grouping parameters configuration attribute \"fields\" is only supported by \"content_based\" type Alert Grouping"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
+					`
+          alert_grouping_parameters {
+            type = "content_based"
+            config {
+              fields = ["custom_details.source_id"]
+            }
+          }
+          `,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("When Alert grouping parameters configuration of type \"content_based\" is in use, attribute \"aggregate\" is required"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
+					`
+          alert_grouping_parameters {
+            type = "content_based"
+            config {
+              aggregate = "any"
+              fields    = []
+            }
+          }
+          `,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("attribute \"fields\" requires at least one field to group the alerts by"),
+			},
 			// Alert grouping parameters "time" type input validation
 			{
 				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
@@ -401,6 +453,173 @@ func TestAccPagerDutyService_FormatValidation(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyService_SupportHoursDaysOutOfOrder(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	config := testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
+		`
+          incident_urgency_rule {
+            type = "use_support_hours"
+
+            during_support_hours {
+              type    = "constant"
+              urgency = "high"
+            }
+
+            outside_support_hours {
+              type    = "constant"
+              urgency = "low"
+            }
+          }
+          support_hours {
+            type         = "fixed_time_per_day"
+            time_zone    = "America/Lima"
+            start_time   = "09:00:00"
+            end_time     = "17:00:00"
+            days_of_week = [ 5, 1, 3, 2, 4 ]
+          }
+          `,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "support_hours.0.days_of_week.#", "5"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "support_hours.0.start_time", "09:00:00"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourcePagerDutyServiceDiff_SupportHoursDaysOutOfOrder(t *testing.T) {
+	r := resourcePagerDutyService()
+	supportHours := func(days ...interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{
+			"type":         "fixed_time_per_day",
+			"time_zone":    "America/Lima",
+			"start_time":   "09:00:00",
+			"end_time":     "17:00:00",
+			"days_of_week": days,
+		}}
+	}
+
+	d := r.TestResourceData()
+	d.SetId("PSVC123")
+	d.Set("name", "foo")
+	d.Set("escalation_policy", "PEP1234")
+	// The days are read sorted, see flattenSupportHours.
+	d.Set("support_hours", flattenSupportHours(&pagerduty.SupportHours{
+		Type:       "fixed_time_per_day",
+		TimeZone:   "America/Lima",
+		StartTime:  "9:00",
+		EndTime:    "17:00:00",
+		DaysOfWeek: []int{5, 1, 3, 2, 4},
+	}))
+	state := d.State()
+	for k, v := range map[string]string{
+		"support_hours.0.days_of_week.0": "1",
+		"support_hours.0.days_of_week.4": "5",
+		"support_hours.0.start_time":     "09:00:00",
+	} {
+		if got := state.Attributes[k]; got != v {
+			t.Errorf("expected %s to be %q, got %q", k, v, got)
+		}
+	}
+
+	cases := []struct {
+		days    []interface{}
+		changed bool
+	}{
+		{days: []interface{}{5, 1, 3, 2, 4}},
+		{days: []interface{}{1, 2, 3, 4, 5}},
+		{days: []interface{}{1, 2, 3, 4, 6}, changed: true},
+		{days: []interface{}{1, 2, 3, 4}, changed: true},
+	}
+	for _, c := range cases {
+		raw := sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":              "foo",
+			"escalation_policy": "PEP1234",
+			"support_hours":     supportHours(c.days...),
+		})
+		diff, err := r.Diff(context.Background(), state, raw, &Config{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		changed := false
+		if diff != nil {
+			for k, attr := range diff.Attributes {
+				if strings.HasPrefix(k, "support_hours.") && attr.Old != attr.New {
+					changed = true
+				}
+			}
+		}
+		if changed != c.changed {
+			t.Errorf("expected the days %v to change the support hours: %t, got %t (%v)", c.days, c.changed, changed, diff)
+		}
+	}
+}
+
+func TestAccPagerDutyService_Disabled(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceConfig(username, email, escalationPolicy, service),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "disabled", "false"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "status", "active"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, "disabled = true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "disabled", "true"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "status", "disabled"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, "disabled = false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "disabled", "false"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "status", "active"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyService_AlertGrouping(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -468,6 +687,207 @@ func TestAccPagerDutyService_AlertGrouping(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyService_AlertGroupingMigration(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceConfigWithAlertGrouping(username, email, escalationPolicy, service),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "alert_grouping", "time"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "alert_grouping_timeout", "1800"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyServiceConfigWithMixedAlertGrouping(username, email, escalationPolicy, service),
+				ExpectError: regexp.MustCompile(`"alert_grouping_parameters": conflicts with alert_grouping`),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceConfigWithAlertGroupingParametersTime(username, email, escalationPolicy, service),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "alert_grouping_parameters.0.type", "time"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "alert_grouping_parameters.0.config.0.timeout", "1500"),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyServiceConfigWithAlertGroupingParametersTime(username, email, escalationPolicy, service),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestResourcePagerDutyServiceStateUpgradeV0(t *testing.T) {
+	cases := []struct {
+		name     string
+		rawState map[string]interface{}
+		expected interface{}
+	}{
+		{
+			name: "time",
+			rawState: map[string]interface{}{
+				"alert_grouping":         "time",
+				"alert_grouping_timeout": "1800",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"type": "time",
+					"config": []interface{}{
+						map[string]interface{}{"aggregate": "", "fields": []interface{}{}, "timeout": 1800, "time_window": 0},
+					},
+				},
+			},
+		},
+		{
+			name: "intelligent",
+			rawState: map[string]interface{}{
+				"alert_grouping":         "intelligent",
+				"alert_grouping_timeout": "null",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"type": "intelligent",
+					"config": []interface{}{
+						map[string]interface{}{"aggregate": "", "fields": []interface{}{}, "timeout": 0, "time_window": 0},
+					},
+				},
+			},
+		},
+		{
+			name: "rules",
+			rawState: map[string]interface{}{
+				"alert_grouping":         "rules",
+				"alert_grouping_timeout": "null",
+			},
+			expected: nil,
+		},
+		{
+			name: "already migrated",
+			rawState: map[string]interface{}{
+				"alert_grouping":         "time",
+				"alert_grouping_timeout": "null",
+				"alert_grouping_parameters": []interface{}{
+					map[string]interface{}{"type": "content_based"},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{"type": "content_based"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state, err := resourcePagerDutyServiceStateUpgradeV0(context.Background(), c.rawState, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := state["alert_grouping_parameters"]; !reflect.DeepEqual(got, c.expected) {
+				t.Errorf("expected alert_grouping_parameters %#v, got %#v", c.expected, got)
+			}
+		})
+	}
+
+	if _, err := resourcePagerDutyServiceStateUpgradeV0(context.Background(), map[string]interface{}{
+		"alert_grouping":         "time",
+		"alert_grouping_timeout": "soon",
+	}, nil); err == nil {
+		t.Error("expected an error for an invalid alert_grouping_timeout")
+	}
+}
+
+func TestAccPagerDutyService_AlertGroupingWithCreateIncidents(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceConfigWithAlertCreationAndGrouping(username, email, escalationPolicy, service, "create_incidents", `
+	alert_grouping_parameters {
+		type = "intelligent"
+	}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`alert_grouping_parameters can't be set when alert_creation is "create_incidents"`),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceConfigWithAlertCreationAndGrouping(username, email, escalationPolicy, service, "create_incidents", `
+	alert_grouping         = "time"
+	alert_grouping_timeout = 1800`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`alert_grouping can't be set when alert_creation is "create_incidents"`),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceConfigWithAlertCreationAndGrouping(username, email, escalationPolicy, service, "create_alerts_and_incidents", `
+	alert_grouping_parameters {
+		type = "intelligent"
+	}`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyService_AutoPauseNotificationsWithCreateIncidents(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceConfigWithAlertCreationAndGrouping(username, email, escalationPolicy, service, "create_incidents", `
+	auto_pause_notifications_parameters {
+		enabled = true
+		timeout = 300
+	}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`auto_pause_notifications_parameters.enabled can't be true when alert_creation is "create_incidents"`),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceConfigWithAlertCreationAndGrouping(username, email, escalationPolicy, service, "create_incidents", `
+	auto_pause_notifications_parameters {
+		enabled = false
+	}`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckPagerDutyServiceConfigWithAlertCreationAndGrouping(username, email, escalationPolicy, service, "create_alerts_and_incidents", `
+	auto_pause_notifications_parameters {
+		enabled = true
+		timeout = 300
+	}`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyService_AlertContentGrouping(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -746,7 +1166,131 @@ func TestAccPagerDutyService_AlertContentGroupingIntelligentTimeWindow(t *testin
 	})
 }
 
-func TestAccPagerDutyService_AutoPauseNotificationsParameters(t *testing.T) {
+func TestAccPagerDutyService_AlertGroupingRecommendedTimeout(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
+					`
+          alert_grouping_parameters {
+            type = "time"
+            config {
+              use_recommended_timeout = true
+            }
+          }
+          `,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Alert grouping parameters configuration attribute \"use_recommended_timeout\" is only supported by \"intelligent\" type Alert Grouping"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
+					`
+          alert_grouping_parameters {
+            type = "intelligent"
+            config {
+              time_window             = 900
+              use_recommended_timeout = true
+            }
+          }
+          `,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Alert grouping parameters configuration attributes \"use_recommended_timeout\" and \"time_window\" can't be set together"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceConfigWithAlertContentGroupingIntelligentTimeWindowUpdated(username, email, escalationPolicy, service),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "alert_grouping_parameters.0.config.0.time_window", "900"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "alert_grouping_parameters.0.config.0.use_recommended_timeout", "false"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceConfigWithAlertGroupingRecommendedTimeout(username, email, escalationPolicy, service),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "alert_grouping_parameters.0.type", "intelligent"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "alert_grouping_parameters.0.config.0.use_recommended_timeout", "true"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_service.foo", "alert_grouping_parameters.0.config.0.time_window"),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyServiceConfigWithAlertGroupingRecommendedTimeout(username, email, escalationPolicy, service),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestValidateContentBasedAlertGroupingConfig(t *testing.T) {
+	cases := []struct {
+		name     string
+		agpType  string
+		agg      string
+		fields   []interface{}
+		unknown  bool
+		expected string
+	}{
+		{name: "valid all", agpType: "content_based", agg: "all", fields: []interface{}{"custom_details.source_id"}},
+		{name: "valid any", agpType: "content_based", agg: "any", fields: []interface{}{"summary", "component"}},
+		{name: "other type", agpType: "intelligent"},
+		{name: "unknown values", agpType: "content_based", unknown: true},
+		{name: "missing both", agpType: "content_based", expected: `attributes "aggregate" and "fields" are required`},
+		{name: "missing aggregate", agpType: "content_based", fields: []interface{}{"summary"}, expected: `attribute "aggregate" is required`},
+		{name: "missing fields", agpType: "content_based", agg: "all", expected: `attribute "fields" requires at least one field`},
+		{name: "empty field", agpType: "content_based", agg: "all", fields: []interface{}{"summary", " "}, expected: `field 1 is empty`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateContentBasedAlertGroupingConfig(c.agpType, c.agg, c.fields, !c.unknown)
+			if c.expected == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.expected) {
+				t.Errorf("expected an error containing %q, got %v", c.expected, err)
+			}
+		})
+	}
+}
+
+func TestExpandAlertGroupingConfigRecommendedTimeout(t *testing.T) {
+	config := func(useRecommendedTimeout bool) interface{} {
+		return []interface{}{map[string]interface{}{
+			"timeout":                 0,
+			"fields":                  []interface{}{},
+			"aggregate":               "",
+			"time_window":             900,
+			"use_recommended_timeout": useRecommendedTimeout,
+		}}
+	}
+
+	if got := expandAlertGroupingConfig("intelligent", config(true)); got.TimeWindow != nil {
+		t.Errorf("expected the recommended timeout to leave the time window out, got %d", *got.TimeWindow)
+	}
+	if got := expandAlertGroupingConfig("intelligent", config(false)); got.TimeWindow == nil || *got.TimeWindow != 900 {
+		t.Errorf("expected the time window to be 900, got %v", got.TimeWindow)
+	}
+}
+
+func TestAccPagerDutyService_AutoPauseNotificationsParameters(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
 	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
@@ -1172,6 +1716,214 @@ func TestAccPagerDutyService_SupportHoursChange(t *testing.T) {
 	}
 }
 
+func TestAccPagerDutyService_SupportHoursValidation(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
+					`
+          incident_urgency_rule {
+            type = "use_support_hours"
+
+            during_support_hours {
+              type    = "constant"
+              urgency = "high"
+            }
+
+          }
+          support_hours {
+            type         = "fixed_time_per_day"
+            time_zone    = "America/Lima"
+            start_time   = "09:00:00"
+            end_time     = "17:00:00"
+            days_of_week = [ 1, 2, 3, 4, 5 ]
+          }
+          `,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("you must specify both during_support_hours and outside_support_hours blocks"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
+					`
+          incident_urgency_rule {
+            type = "use_support_hours"
+
+            during_support_hours {
+              type    = "constant"
+              urgency = "high"
+            }
+
+            outside_support_hours {
+              type    = "constant"
+              urgency = "low"
+            }
+          }
+          support_hours {
+            type         = "fixed_time_per_day"
+            time_zone    = "America/Lima"
+            start_time   = "9am"
+            end_time     = "17:00:00"
+            days_of_week = [ 1, 2, 3, 4, 5 ]
+          }
+          `,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be a time of the day in the HH:MM:SS format"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
+					`
+          incident_urgency_rule {
+            type = "use_support_hours"
+
+            during_support_hours {
+              type    = "constant"
+              urgency = "high"
+            }
+
+            outside_support_hours {
+              type    = "constant"
+              urgency = "low"
+            }
+          }
+          support_hours {
+            type         = "fixed_time_per_day"
+            time_zone    = "America/Lima"
+            start_time   = "09:00:00"
+            end_time     = "17:00:00"
+            days_of_week = [ 1, 2, 8 ]
+          }
+          `,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("expected support_hours.0.days_of_week.2 to be in the range \\(1 - 7\\)"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service,
+					`
+          incident_urgency_rule {
+            type = "use_support_hours"
+
+            during_support_hours {
+              type    = "constant"
+              urgency = "high"
+            }
+
+            outside_support_hours {
+              type    = "constant"
+              urgency = "low"
+            }
+          }
+          support_hours {
+            type         = "fixed_time_per_day"
+            time_zone    = "America/Lima"
+            start_time   = "09:00:00"
+            end_time     = "17:00:00"
+            days_of_week = [ 1, 2, 3, 4, 5 ]
+          }
+          `,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.type", "use_support_hours"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.during_support_hours.0.urgency", "high"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.outside_support_hours.0.urgency", "low"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "support_hours.0.start_time", "09:00:00"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "support_hours.0.days_of_week.#", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyService_ConstantIncidentUrgencyValidation(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, `
+  incident_urgency_rule {
+    type = "constant"
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("urgency must be set for the constant incident urgency rule incident_urgency_rule.0"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, `
+  incident_urgency_rule {
+    type    = "constant"
+    urgency = "medium"
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"medium" is an invalid urgency of the constant incident urgency rule incident_urgency_rule.0`),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, `
+  incident_urgency_rule {
+    type = "use_support_hours"
+
+    during_support_hours {
+      type    = "constant"
+      urgency = "high"
+    }
+    outside_support_hours {
+      type = "constant"
+    }
+  }
+
+  support_hours {
+    type         = "fixed_time_per_day"
+    time_zone    = "America/Lima"
+    start_time   = "09:00:00"
+    end_time     = "17:00:00"
+    days_of_week = [1, 2, 3, 4, 5]
+  }
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("urgency must be set for the constant incident urgency rule incident_urgency_rule.0.outside_support_hours.0"),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, `
+  incident_urgency_rule {
+    type    = "constant"
+    urgency = "low"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.type", "constant"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "incident_urgency_rule.0.urgency", "low"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyService_ResponsePlay(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -1260,6 +2012,46 @@ func TestAccPagerDutyService_ResponsePlay(t *testing.T) {
 
 }
 
+func TestAccPagerDutyService_ResponsePlayDetach(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	responsePlay := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceWithResponsePlayConfig(username, email, escalationPolicy, responsePlay, service),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_service.foo", "response_play", "pagerduty_response_play.foo", "id"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyServiceWithNullResponsePlayConfig(username, email, escalationPolicy, responsePlay, service),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "response_play", ""),
+					testAccCheckPagerDutyServiceResponsePlayNotExist("pagerduty_service.foo"),
+				),
+			},
+			{
+				Config: strings.Replace(
+					testAccCheckPagerDutyServiceWithNullResponsePlayConfig(username, email, escalationPolicy, responsePlay, service),
+					"response_play           = null", `response_play           = "PNOPE12"`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`response_play "PNOPE12" of the service doesn't exist`),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyService_AlertGroupingParametersAddConfigField(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -1501,6 +2293,124 @@ resource "pagerduty_service" "foo" {
 `, username, email, escalationPolicy, service)
 }
 
+func testAccCheckPagerDutyServiceConfigWithMixedAlertGrouping(username, email, escalationPolicy, service string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name        = "%s"
+	email       = "%s"
+	color       = "green"
+	role        = "user"
+	job_title   = "foo"
+	description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name        = "%s"
+	description = "bar"
+	num_loops   = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name                    = "%s"
+	description             = "foo"
+	auto_resolve_timeout    = 1800
+	acknowledgement_timeout = 1800
+	escalation_policy       = pagerduty_escalation_policy.foo.id
+	alert_creation          = "create_alerts_and_incidents"
+	alert_grouping          = "time"
+	alert_grouping_timeout  = 1800
+	alert_grouping_parameters {
+		type = "time"
+		config {
+			timeout = 1500
+		}
+	}
+}
+`, username, email, escalationPolicy, service)
+}
+
+func testAccCheckPagerDutyServiceConfigWithAlertGroupingParametersTime(username, email, escalationPolicy, service string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name        = "%s"
+	email       = "%s"
+	color       = "green"
+	role        = "user"
+	job_title   = "foo"
+	description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name        = "%s"
+	description = "bar"
+	num_loops   = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name                    = "%s"
+	description             = "foo"
+	auto_resolve_timeout    = 1800
+	acknowledgement_timeout = 1800
+	escalation_policy       = pagerduty_escalation_policy.foo.id
+	alert_creation          = "create_alerts_and_incidents"
+	alert_grouping_parameters {
+		type = "time"
+		config {
+			timeout = 1500
+		}
+	}
+}
+`, username, email, escalationPolicy, service)
+}
+
+func testAccCheckPagerDutyServiceConfigWithAlertCreationAndGrouping(username, email, escalationPolicy, service, alertCreation, alertGrouping string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name        = "%s"
+	email       = "%s"
+	color       = "green"
+	role        = "user"
+	job_title   = "foo"
+	description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name        = "%s"
+	description = "bar"
+	num_loops   = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name                    = "%s"
+	description             = "foo"
+	escalation_policy       = pagerduty_escalation_policy.foo.id
+	alert_creation          = "%s"
+%s
+}
+`, username, email, escalationPolicy, service, alertCreation, alertGrouping)
+}
+
 func testAccCheckPagerDutyServiceConfigWithAlertContentGrouping(username, email, escalationPolicy, service string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -1621,6 +2531,47 @@ resource "pagerduty_service" "foo" {
 `, username, email, escalationPolicy, service)
 }
 
+func testAccCheckPagerDutyServiceConfigWithAlertGroupingRecommendedTimeout(username, email, escalationPolicy, service string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name        = "%s"
+	email       = "%s"
+	color       = "green"
+	role        = "user"
+	job_title   = "foo"
+	description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name        = "%s"
+	description = "bar"
+	num_loops   = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name                    = "%s"
+	description             = "foo"
+	auto_resolve_timeout    = 1800
+	acknowledgement_timeout = 1800
+	escalation_policy       = pagerduty_escalation_policy.foo.id
+	alert_creation          = "create_alerts_and_incidents"
+	alert_grouping_parameters {
+        type = "intelligent"
+        config {
+            use_recommended_timeout = true
+        }
+    }
+}
+`, username, email, escalationPolicy, service)
+}
+
 func testAccCheckPagerDutyServiceConfigWithAlertContentGroupingUpdated(username, email, escalationPolicy, service string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -26,6 +27,7 @@ func resourcePagerDutySlackConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutySlackConnectionImport,
 		},
+		CustomizeDiff: customizeSlackConnectionDiff,
 		Schema: map[string]*schema.Schema{
 			"source_id": {
 				Type:     schema.TypeString,
@@ -98,6 +100,34 @@ func resourcePagerDutySlackConnection() *schema.Resource {
 	}
 }
 
+// slackConnectionNotificationTypes are the notification types each type of
+// source of the Slack connections accepts, the channels connected to teams are
+// only notified as responders.
+var slackConnectionNotificationTypes = map[string][]string{
+	"service_reference": {"responder", "stakeholder"},
+	"team_reference":    {"responder"},
+}
+
+func customizeSlackConnectionDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("source_type") || !diff.NewValueKnown("notification_type") {
+		return nil
+	}
+	return validateSlackConnectionNotificationType(diff.Get("source_type").(string), diff.Get("notification_type").(string))
+}
+
+func validateSlackConnectionNotificationType(sourceType, notificationType string) error {
+	accepted, ok := slackConnectionNotificationTypes[sourceType]
+	if !ok {
+		return nil
+	}
+	for _, t := range accepted {
+		if t == notificationType {
+			return nil
+		}
+	}
+	return fmt.Errorf("notification_type %q isn't supported by the Slack connections of source_type %q, use %s", notificationType, sourceType, strings.Join(accepted, " or "))
+}
+
 func buildSlackConnectionStruct(d *schema.ResourceData) (*pagerduty.SlackConnection, error) {
 	slackConn := pagerduty.SlackConnection{
 		SourceID:         d.Get("source_id").(string),
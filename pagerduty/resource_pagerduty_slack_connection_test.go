@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -37,6 +39,8 @@ func TestAccPagerDutySlackConnection_Basic(t *testing.T) {
 					testAccCheckPagerDutySlackConnectionExists("pagerduty_slack_connection.foo"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_slack_connection.foo", "source_name", service),
+					resource.TestCheckResourceAttr(
+						"pagerduty_slack_connection.foo", "notification_type", "responder"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_slack_connection.foo", "config.0.events.#", "13"),
 				),
@@ -45,6 +49,8 @@ func TestAccPagerDutySlackConnection_Basic(t *testing.T) {
 				Config: testAccCheckPagerDutySlackConnectionConfigUpdated(username, email, escalationPolicy, service, workspaceID, channelID),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPagerDutySlackConnectionExists("pagerduty_slack_connection.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_slack_connection.foo", "notification_type", "stakeholder"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_slack_connection.foo", "config.0.urgency", ""),
 				),
@@ -61,6 +67,11 @@ func TestAccPagerDutySlackConnection_Team(t *testing.T) {
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckPagerDutySlackConnectionDestroy,
 		Steps: []resource.TestStep{
+			{
+				Config:      strings.Replace(testAccCheckPagerDutySlackConnectionConfigTeam(team, workspaceID, channelID), `notification_type = "responder"`, `notification_type = "stakeholder"`, 1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`notification_type "stakeholder" isn't supported by the Slack connections of source_type "team_reference", use responder`),
+			},
 			{
 				Config: testAccCheckPagerDutySlackConnectionConfigTeam(team, workspaceID, channelID),
 				Check: resource.ComposeTestCheckFunc(
@@ -138,6 +149,28 @@ func TestAccPagerDutySlackConnection_NonAndAnyPriorities(t *testing.T) {
 	})
 }
 
+func TestValidateSlackConnectionNotificationType(t *testing.T) {
+	cases := []struct {
+		sourceType       string
+		notificationType string
+		valid            bool
+	}{
+		{sourceType: "service_reference", notificationType: "responder", valid: true},
+		{sourceType: "service_reference", notificationType: "stakeholder", valid: true},
+		{sourceType: "team_reference", notificationType: "responder", valid: true},
+		{sourceType: "team_reference", notificationType: "stakeholder"},
+	}
+	for _, c := range cases {
+		err := validateSlackConnectionNotificationType(c.sourceType, c.notificationType)
+		if c.valid && err != nil {
+			t.Errorf("expected %s notifications of a %s connection to be valid, got %v", c.notificationType, c.sourceType, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("expected %s notifications of a %s connection to be rejected", c.notificationType, c.sourceType)
+		}
+	}
+}
+
 func testAccCheckPagerDutySlackConnectionDestroy(s *terraform.State) error {
 	config := &pagerduty.Config{
 		Token:   os.Getenv("PAGERDUTY_USER_TOKEN"),
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"fmt"
 	"log"
 	"net/http"
 	"time"
@@ -34,6 +35,10 @@ func resourcePagerDutyTeam() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"self": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"parent": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -42,7 +47,12 @@ func resourcePagerDutyTeam() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ValidateDiagFunc: validateValueDiagFunc([]string{
+					"manager",
+					"none",
+				}),
 			},
+			"tags": entityTagsSchema,
 		},
 	}
 }
@@ -94,6 +104,10 @@ func resourcePagerDutyTeamCreate(d *schema.ResourceData, meta interface{}) error
 		return retryErr
 	}
 
+	if err := updateEntityTags(client, d, "teams"); err != nil {
+		return err
+	}
+
 	return resourcePagerDutyTeamRead(d, meta)
 }
 
@@ -120,7 +134,12 @@ func resourcePagerDutyTeamRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("name", team.Name)
 			d.Set("description", team.Description)
 			d.Set("html_url", team.HTMLURL)
+			d.Set("self", team.Self)
 			d.Set("default_role", team.DefaultRole)
+
+			if err := readEntityTags(client, d, "teams"); err != nil {
+				return retry.NonRetryableError(fmt.Errorf("error reading tags: %s", err))
+			}
 		}
 		return nil
 	})
@@ -146,6 +165,11 @@ func resourcePagerDutyTeamUpdate(d *schema.ResourceData, meta interface{}) error
 		time.Sleep(2 * time.Second)
 		return retryErr
 	}
+
+	if err := updateEntityTags(client, d, "teams"); err != nil {
+		return err
+	}
+
 	return resourcePagerDutyTeamRead(d, meta)
 }
 
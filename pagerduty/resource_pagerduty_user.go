@@ -1,12 +1,14 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -15,13 +17,14 @@ import (
 
 func resourcePagerDutyUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePagerDutyUserCreate,
-		Read:   resourcePagerDutyUserRead,
-		Update: resourcePagerDutyUserUpdate,
-		Delete: resourcePagerDutyUserDelete,
+		Create:        resourcePagerDutyUserCreate,
+		Read:          resourcePagerDutyUserRead,
+		UpdateContext: resourcePagerDutyUserUpdateContext,
+		Delete:        resourcePagerDutyUserDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourcePagerDutyUserImport,
 		},
+		CustomizeDiff: customizePagerDutyUserDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -58,6 +61,12 @@ func resourcePagerDutyUser() *schema.Resource {
 				}),
 			},
 
+			"protect_last_owner": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"job_title": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -91,6 +100,11 @@ func resourcePagerDutyUser() *schema.Resource {
 				Computed: true,
 			},
 
+			"self": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"invitation_sent": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -107,10 +121,70 @@ func resourcePagerDutyUser() *schema.Resource {
 				Optional: true,
 				Type:     schema.TypeString,
 			},
+
+			"tags": entityTagsSchema,
 		},
 	}
 }
 
+// userAdministrativeRoles are the roles a user loses the administration of
+// the account with when downgraded from.
+var userAdministrativeRoles = map[string]bool{
+	"admin": true,
+	"owner": true,
+}
+
+// isUserRoleDowngraded reports whether a user changing from oldRole to newRole
+// loses the administration of the account.
+func isUserRoleDowngraded(oldRole, newRole string) bool {
+	return oldRole != newRole && userAdministrativeRoles[oldRole] && !(oldRole == "admin" && newRole == "owner")
+}
+
+// userRoleDowngradeWarning warns about the user downgraded from an
+// administrative role, which may lock the account out of its own
+// administration.
+func userRoleDowngradeWarning(id, oldRole, newRole string) diag.Diagnostics {
+	if !isUserRoleDowngraded(oldRole, newRole) {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("PagerDuty user %s is downgraded from the %s role to %s", id, oldRole, newRole),
+		Detail:   "The user won't be able to administer the account anymore. Make sure another user keeps administering it.",
+	}}
+}
+
+// customizePagerDutyUserDiff refuses to downgrade the last owner of the
+// account when protect_last_owner is set.
+func customizePagerDutyUserDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("role") || !diff.NewValueKnown("role") {
+		return nil
+	}
+
+	o, n := diff.GetChange("role")
+	oldRole, newRole := o.(string), n.(string)
+	if !isUserRoleDowngraded(oldRole, newRole) || oldRole != "owner" || !diff.Get("protect_last_owner").(bool) {
+		return nil
+	}
+
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return err
+	}
+	users, err := listAllUsers(client, &pagerduty.ListUsersOptions{})
+	if err != nil {
+		return fmt.Errorf("error checking the other owners of the account: %w", err)
+	}
+	for _, u := range users {
+		if u.ID != diff.Id() && u.Role == "owner" {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("role: PagerDuty user %s is the last owner of the account and can't be downgraded to %s, make another user an owner first or unset protect_last_owner", diff.Id(), newRole)
+}
+
 func buildUserStruct(d *schema.ResourceData) *pagerduty.User {
 	user := &pagerduty.User{
 		Name:  strings.TrimSpace(d.Get("name").(string)),
@@ -149,18 +223,41 @@ func buildUserStruct(d *schema.ResourceData) *pagerduty.User {
 	return user
 }
 
+// resolveUserLicense replaces the license name set on the user with the ID of
+// the license it references.
+func resolveUserLicense(c *Config, user *pagerduty.User) error {
+	if user.License == nil {
+		return nil
+	}
+
+	id, err := c.resolveLicenseID(user.License.ID)
+	if err != nil {
+		return err
+	}
+	user.License.ID = id
+
+	return nil
+}
+
 func resourcePagerDutyUserCreate(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
 
 	user := buildUserStruct(d)
+	if err := resolveUserLicense(config, user); err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Creating PagerDuty user %s", user.Name)
 
 	user, _, err = client.Users.Create(user)
 	if err != nil {
+		if d.Get("license").(string) == "" && isLicenseRequiredError(err) {
+			return fmt.Errorf("%w\n\nThe account requires a license to be assigned to new users, set the \"license\" argument of pagerduty_user to the ID or the name of one of the licenses available for the account", err)
+		}
 		return err
 	}
 
@@ -170,7 +267,8 @@ func resourcePagerDutyUserCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
@@ -197,12 +295,21 @@ func resourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("email", user.Email)
 		d.Set("time_zone", user.TimeZone)
 		d.Set("html_url", user.HTMLURL)
+		d.Set("self", user.Self)
 		d.Set("color", user.Color)
 		d.Set("role", user.Role)
 		d.Set("avatar_url", user.AvatarURL)
 		d.Set("description", user.Description)
 		d.Set("job_title", user.JobTitle)
-		d.Set("license", user.License.ID)
+		if user.License != nil {
+			license := user.License.ID
+			// Keep the license as referenced in the configuration when it's a
+			// name resolving to the license assigned to the user.
+			if ref := d.Get("license").(string); config.licenseRefMatchesID(ref, license) {
+				license = ref
+			}
+			d.Set("license", license)
+		}
 
 		if err := d.Set("teams", flattenTeams(user.Teams)); err != nil {
 			return retry.NonRetryableError(
@@ -212,12 +319,35 @@ func resourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error {
 
 		d.Set("invitation_sent", user.InvitationSent)
 
+		if err := readEntityTags(client, d, "users"); err != nil {
+			return retry.NonRetryableError(
+				fmt.Errorf("error reading tags: %s", err),
+			)
+		}
+
 		return nil
 	})
 }
 
+// resourcePagerDutyUserUpdateContext updates the user, warning when it's
+// downgraded from an administrative role. A CustomizeDiff can't return
+// warnings, so the warning is reported when the change is applied.
+func resourcePagerDutyUserUpdateContext(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if d.HasChange("role") {
+		o, n := d.GetChange("role")
+		diags = userRoleDowngradeWarning(d.Id(), o.(string), n.(string))
+	}
+
+	if err := resourcePagerDutyUserUpdate(d, meta); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
 func resourcePagerDutyUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	client, err := meta.(*Config).Client()
+	config := meta.(*Config)
+	client, err := config.Client()
 	if err != nil {
 		return err
 	}
@@ -229,6 +359,9 @@ func resourcePagerDutyUserUpdate(d *schema.ResourceData, meta interface{}) error
 		// logic assign the license's id.
 		user.License = nil
 	}
+	if err := resolveUserLicense(config, user); err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Updating PagerDuty user %s", d.Id())
 
@@ -248,6 +381,16 @@ func resourcePagerDutyUserUpdate(d *schema.ResourceData, meta interface{}) error
 		return retryErr
 	}
 
+	// The API client drops the empty fields from its payload, so the job
+	// title and description emptied in the configuration are cleared with a
+	// request of their own, otherwise the API keeps their previous values.
+	if cleared := clearedUserFields(d); len(cleared) > 0 {
+		log.Printf("[INFO] Clearing fields of PagerDuty user %s", d.Id())
+		if err := putAPIResource(context.Background(), client, fmt.Sprintf("/users/%s", d.Id()), map[string]interface{}{"user": cleared}, nil); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("teams") {
 		o, n := d.GetChange("teams")
 
@@ -288,9 +431,25 @@ func resourcePagerDutyUserUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if err := updateEntityTags(client, d, "users"); err != nil {
+		return err
+	}
+
 	return resourcePagerDutyUserRead(d, meta)
 }
 
+// clearedUserFields returns the fields of the user which changed to an empty
+// value.
+func clearedUserFields(d *schema.ResourceData) map[string]interface{} {
+	cleared := make(map[string]interface{})
+	for _, k := range []string{"job_title", "description"} {
+		if d.HasChange(k) && d.Get(k).(string) == "" {
+			cleared[k] = ""
+		}
+	}
+	return cleared
+}
+
 func resourcePagerDutyUserDelete(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -322,6 +481,11 @@ func resourcePagerDutyUserDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func resourcePagerDutyUserImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("protect_last_owner", false)
+	return []*schema.ResourceData{d}, nil
+}
+
 func expandLicenseReference(v interface{}) (*pagerduty.LicenseReference, error) {
 	license := &pagerduty.LicenseReference{
 		ID:   v.(string),
@@ -117,6 +117,10 @@ func customizeDiffResourceUserContactMethod(context context.Context, diff *schem
 	return nil
 }
 
+// pushNotificationContactMethodType is the type of the contact methods created
+// by the PagerDuty mobile app for each of the devices of a user.
+const pushNotificationContactMethodType = "push_notification_contact_method"
+
 func buildUserContactMethodStruct(d *schema.ResourceData) *pagerduty.ContactMethod {
 	contactMethod := &pagerduty.ContactMethod{
 		Type:    d.Get("type").(string),
@@ -163,6 +167,16 @@ func fetchPagerDutyUserContactMethod(d *schema.ResourceData, meta interface{}, e
 			return nil
 		}
 
+		// Push contact methods are registered by the mobile app. Unless the
+		// resource explicitly manages one, a push method found under this ID
+		// isn't reconciled, otherwise the type change would replace it and
+		// unregister the device.
+		if resp.Type == pushNotificationContactMethodType && d.Get("type").(string) != pushNotificationContactMethodType {
+			log.Printf("[WARN] PagerDuty user contact method %s is a push notification contact method, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		d.Set("address", resp.Address)
 		d.Set("blacklisted", resp.BlackListed)
 		d.Set("country_code", resp.CountryCode)
@@ -250,11 +264,15 @@ func resourcePagerDutyUserContactMethodImport(d *schema.ResourceData, meta inter
 	}
 	uid, id := ids[0], ids[1]
 
-	_, _, err = client.Users.GetContactMethod(uid, id)
+	contactMethod, _, err := client.Users.GetContactMethod(uid, id)
 	if err != nil {
 		return []*schema.ResourceData{}, err
 	}
 
+	if contactMethod.Type == pushNotificationContactMethodType {
+		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_user_contact_method. %s is a push notification contact method, which is registered by the PagerDuty mobile app and can't be imported", id)
+	}
+
 	d.SetId(id)
 	d.Set("user_id", uid)
 
@@ -2,12 +2,15 @@ package pagerduty
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func TestAccPagerDutyUserContactMethodEmail_Basic(t *testing.T) {
@@ -37,6 +40,34 @@ func TestAccPagerDutyUserContactMethodEmail_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyUserContactMethodEmail_SameLabel(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserContactMethodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserContactMethodEmailSameLabelConfig(username, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserContactMethodExists("pagerduty_user_contact_method.foo"),
+					testAccCheckPagerDutyUserContactMethodExists("pagerduty_user_contact_method.bar"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user_contact_method.foo", "address", "foo."+email),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user_contact_method.bar", "address", "bar."+email),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyUserContactMethodEmailSameLabelConfig(username, email),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyUserContactMethodPhone_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	usernameUpdated := fmt.Sprintf("tf-%s", acctest.RandString(5))
@@ -183,6 +214,41 @@ func TestAccPagerDutyUserContactMethodPhone_NoPermaDiffWhenOmittingCountryCode(t
 	})
 }
 
+func TestAccPagerDutyUserContactMethod_IgnorePushNotification(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserContactMethodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserContactMethodWithPushConfig(username, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserContactMethodExists("pagerduty_user_contact_method.email"),
+					testAccCheckPagerDutyUserContactMethodExists("pagerduty_user_contact_method.phone"),
+					testAccAddPushContactOutsideTerraform("pagerduty_user.foo", username),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyUserContactMethodWithPushConfig(username, email),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCheckPagerDutyUserContactMethodWithPushConfig(username, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_user_contact_method.email", "type", "email_contact_method"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user_contact_method.phone", "type", "phone_contact_method"),
+					testAccCheckPagerDutyUserHasContactMethod("pagerduty_user.foo", "push_notification_contact_method"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyUserContactMethodDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -254,6 +320,56 @@ func testAccAddPhoneContactOutsideTerraform(n, p string) resource.TestCheckFunc
 	}
 }
 
+func testAccAddPushContactOutsideTerraform(n, label string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No User ID is set")
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		push := &pagerduty.ContactMethod{
+			Type:       "push_notification_contact_method",
+			Label:      label,
+			Address:    acctest.RandStringFromCharSet(64, "0123456789abcdef"),
+			DeviceType: "ios",
+		}
+		if _, _, err := client.Users.CreateContactMethod(rs.Primary.ID, push); err != nil {
+			return fmt.Errorf("was not possible to add a push contact method outside Terraform state: %v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyUserHasContactMethod(n, contactMethodType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+
+		resp, _, err := client.Users.ListContactMethods(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, contactMethod := range resp.ContactMethods {
+			if contactMethod.Type == contactMethodType {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Expected user %s to have a %s", rs.Primary.ID, contactMethodType)
+	}
+}
+
 func testAccCheckPagerDutyUserContactMethodEmailConfig(username, email string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -274,6 +390,33 @@ resource "pagerduty_user_contact_method" "foo" {
 `, username, email)
 }
 
+func testAccCheckPagerDutyUserContactMethodEmailSameLabelConfig(username, email string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%[1]v"
+  email       = "%[2]v"
+  color       = "red"
+  role        = "user"
+  job_title   = "bar"
+  description = "bar"
+}
+
+resource "pagerduty_user_contact_method" "foo" {
+  user_id = pagerduty_user.foo.id
+  type    = "email_contact_method"
+  address = "foo.%[2]v"
+  label   = "Work"
+}
+
+resource "pagerduty_user_contact_method" "bar" {
+  user_id = pagerduty_user.foo.id
+  type    = "email_contact_method"
+  address = "bar.%[2]v"
+  label   = "Work"
+}
+`, username, email)
+}
+
 func testAccCheckPagerDutyUserContactMethodEmailConfigUpdated(username, email string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -397,3 +540,62 @@ resource "pagerduty_user_contact_method" "foo" {
 }
 `, username, email, phone)
 }
+
+func testAccCheckPagerDutyUserContactMethodWithPushConfig(username, email string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[2]v"
+}
+
+resource "pagerduty_user_contact_method" "email" {
+  user_id = pagerduty_user.foo.id
+  type    = "email_contact_method"
+  address = "%[1]v%[2]v"
+  label   = "%[1]v"
+}
+
+resource "pagerduty_user_contact_method" "phone" {
+  user_id      = pagerduty_user.foo.id
+  type         = "phone_contact_method"
+  country_code = "+1"
+  address      = "4153013250"
+  label        = "%[1]v"
+}
+`, username, email)
+}
+
+func TestResourcePagerDutyUserContactMethodRead_Enabled(t *testing.T) {
+	enabled := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/users/PUSER01/contact_methods/PCM0001" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(fmt.Sprintf(`{"contact_method":{"id":"PCM0001","type":"sms_contact_method","label":"Mobile","address":"4153013250","country_code":1,"enabled":%t}}`, enabled)))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := resourcePagerDutyUserContactMethod()
+	d := r.TestResourceData()
+	d.SetId("PCM0001")
+	d.Set("user_id", "PUSER01")
+	d.Set("type", "sms_contact_method")
+
+	for _, expected := range []bool{true, false, true} {
+		enabled = expected
+		if err := r.Read(d, config); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := d.Get("enabled").(bool); got != expected {
+			t.Errorf("expected enabled to be read as %t, got %t", expected, got)
+		}
+	}
+}
@@ -1,11 +1,16 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -68,6 +73,10 @@ func TestAccPagerDutyUser_Basic(t *testing.T) {
 				Config: testAccCheckPagerDutyUserConfig(usernameSpaces, email),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPagerDutyUserExists("pagerduty_user.foo"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_user.foo", "html_url"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_user.foo", "self"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_user.foo", "name", username),
 					resource.TestCheckResourceAttr(
@@ -197,6 +206,79 @@ func TestAccPagerDutyUserWithLicenses_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyUserWithLicenses_ByName(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	licensesName := "test"
+	i := "0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserWithLicenseNameConfig(username, email, licensesName, i),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserExistsWithLicense("pagerduty_user.foo", fmt.Sprintf("data.pagerduty_licenses.%s", licensesName), i),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_user.foo", "license", fmt.Sprintf("data.pagerduty_licenses.%s", licensesName), fmt.Sprintf("licenses.%s.name", i)),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyUserWithLicenseNameConfig(username, email, licensesName, i),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyUser_Tags(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	tag := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyUserWithTagsConfig(username, email, tag, "pagerduty_tag.foo.id, pagerduty_tag.bar.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserExists("pagerduty_user.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user.foo", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"pagerduty_user.foo", "tags.*", "pagerduty_tag.foo", "id"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"pagerduty_user.foo", "tags.*", "pagerduty_tag.bar", "id"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyUserWithTagsConfig(username, email, tag, "pagerduty_tag.bar.id, pagerduty_tag.baz.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserExists("pagerduty_user.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user.foo", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"pagerduty_user.foo", "tags.*", "pagerduty_tag.bar", "id"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"pagerduty_user.foo", "tags.*", "pagerduty_tag.baz", "id"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyUserWithTagsConfig(username, email, tag, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyUserExists("pagerduty_user.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_user.foo", "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyUserDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -342,6 +424,23 @@ resource "pagerduty_user" "foo" {
 `, licensesName, username, email, i, i)
 }
 
+func testAccCheckPagerDutyUserWithLicenseNameConfig(username, email, licensesName, i string) string {
+	return fmt.Sprintf(`
+locals {
+	invalid_roles = ["owner"]
+}
+
+data "pagerduty_licenses" "%s" {}
+
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+	license = data.pagerduty_licenses.test.licenses[%s].name
+	role = tolist(setsubtract(data.pagerduty_licenses.test.licenses[%s].valid_roles, local.invalid_roles))[0]
+}
+`, licensesName, username, email, i, i)
+}
+
 func testAccCheckPagerDutyUserWithTeamsConfigUpdated(team1, team2, username, email string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_team" "foo" {
@@ -385,3 +484,112 @@ resource "pagerduty_user" "foo" {
 }
 `, team1, team2, username, email)
 }
+
+func testAccCheckPagerDutyUserWithTagsConfig(username, email, tag, tags string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_tag" "foo" {
+  label = "%[3]s-foo"
+}
+
+resource "pagerduty_tag" "bar" {
+  label = "%[3]s-bar"
+}
+
+resource "pagerduty_tag" "baz" {
+  label = "%[3]s-baz"
+}
+
+resource "pagerduty_user" "foo" {
+  name  = "%[1]s"
+  email = "%[2]s"
+  tags  = [%[4]s]
+}
+`, username, email, tag, tags)
+}
+
+func TestCustomizePagerDutyUserDiff_LastOwner(t *testing.T) {
+	owners := `{"id":"POWNER1","name":"Owner","email":"owner@foo.test","role":"owner"}`
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/users" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests++
+		w.Write([]byte(`{"users":[` + owners + `,{"id":"PUSER01","name":"User","email":"user@foo.test","role":"admin"}],"limit":25,"offset":0,"more":false}`))
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := resourcePagerDutyUser()
+	d := r.TestResourceData()
+	d.SetId("POWNER1")
+	d.Set("name", "Owner")
+	d.Set("email", "owner@foo.test")
+	d.Set("role", "owner")
+	d.Set("description", "Managed by Terraform")
+	state := d.State()
+
+	diff := func(role string, protect bool) error {
+		raw := sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":               "Owner",
+			"email":              "owner@foo.test",
+			"role":               role,
+			"protect_last_owner": protect,
+		})
+		_, err := r.Diff(context.Background(), state, raw, config)
+		return err
+	}
+
+	err := diff("admin", true)
+	if err == nil || !strings.Contains(err.Error(), "PagerDuty user POWNER1 is the last owner of the account and can't be downgraded to admin") {
+		t.Errorf("expected downgrading the last owner to fail, got %v", err)
+	}
+
+	requests = 0
+	if err := diff("admin", false); err != nil {
+		t.Errorf("expected downgrading the last owner without protect_last_owner to pass, got %v", err)
+	}
+	if err := diff("owner", true); err != nil {
+		t.Errorf("expected the owner to be kept, got %v", err)
+	}
+	if requests > 0 {
+		t.Errorf("expected the owners not to be listed, got %d requests", requests)
+	}
+
+	owners += `,{"id":"POWNER2","name":"Other owner","email":"other@foo.test","role":"owner"}`
+	if err := diff("admin", true); err != nil {
+		t.Errorf("expected downgrading an owner with another owner to pass, got %v", err)
+	}
+}
+
+func TestUserRoleDowngradeWarning(t *testing.T) {
+	cases := []struct {
+		oldRole, newRole string
+		warned           bool
+	}{
+		{oldRole: "owner", newRole: "admin", warned: true},
+		{oldRole: "owner", newRole: "user", warned: true},
+		{oldRole: "admin", newRole: "observer", warned: true},
+		{oldRole: "admin", newRole: "owner"},
+		{oldRole: "admin", newRole: "admin"},
+		{oldRole: "user", newRole: "observer"},
+		{oldRole: "", newRole: "user"},
+	}
+	for _, c := range cases {
+		diags := userRoleDowngradeWarning("PUSER01", c.oldRole, c.newRole)
+		if warned := len(diags) > 0; warned != c.warned {
+			t.Errorf("expected a warning for %q to %q: %t, got %v", c.oldRole, c.newRole, c.warned, diags)
+			continue
+		}
+		if c.warned && (diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Summary, "PagerDuty user PUSER01 is downgraded from the "+c.oldRole+" role to "+c.newRole)) {
+			t.Errorf("unexpected warning for %q to %q: %v", c.oldRole, c.newRole, diags)
+		}
+	}
+}
@@ -1,24 +1,30 @@
 package pagerduty
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
 func resourcePagerDutyWebhookSubscription() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePagerDutyWebhookSubscriptionCreate,
-		Read:   resourcePagerDutyWebhookSubscriptionRead,
-		Update: resourcePagerDutyWebhookSubscriptionUpdate,
-		Delete: resourcePagerDutyWebhookSubscriptionDelete,
+		CreateContext: resourcePagerDutyWebhookSubscriptionCreateContext,
+		ReadContext:   resourcePagerDutyWebhookSubscriptionRead,
+		UpdateContext: resourcePagerDutyWebhookSubscriptionUpdateContext,
+		Delete:        resourcePagerDutyWebhookSubscriptionDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizePagerDutyWebhookSubscriptionDiff,
 		Schema: map[string]*schema.Schema{
 			"delivery_method": {
 				Type:     schema.TypeList,
@@ -119,6 +125,85 @@ func resourcePagerDutyWebhookSubscription() *schema.Resource {
 	}
 }
 
+func customizePagerDutyWebhookSubscriptionDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	filters := diff.Get("filter").([]interface{})
+	for i, raw := range filters {
+		f, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		idKey := fmt.Sprintf("filter.%d.id", i)
+		typeKey := fmt.Sprintf("filter.%d.type", i)
+		if !diff.NewValueKnown(idKey) || !diff.NewValueKnown(typeKey) {
+			continue
+		}
+		if err := validateWebhookSubscriptionFilter(f["type"].(string), f["id"].(string)); err != nil {
+			return fmt.Errorf("%s: %w", idKey, err)
+		}
+	}
+	return nil
+}
+
+// webhookSubscriptionEventAbilities are the abilities the account is expected
+// to need for the webhooks to be subscribed to the event types. The API
+// doesn't document them, so a missing ability only produces a warning.
+var webhookSubscriptionEventAbilities = map[string]string{
+	"incident.responder.added":   "coordinated_responding",
+	"incident.responder.replied": "coordinated_responding",
+}
+
+// webhookSubscriptionEventAbilitiesWarnings warns about the event types the
+// abilities of the account may not allow. A CustomizeDiff can't return
+// warnings, so they're reported when the subscription is created or updated.
+// The check is skipped when the abilities can't be read.
+func webhookSubscriptionEventAbilitiesWarnings(c *Config, events []interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var abilities []string
+	for i, raw := range events {
+		event, ok := raw.(string)
+		if !ok {
+			continue
+		}
+		ability, ok := webhookSubscriptionEventAbilities[event]
+		if !ok {
+			continue
+		}
+
+		if abilities == nil {
+			var err error
+			abilities, err = c.listAbilities()
+			if err != nil {
+				log.Printf("[WARN] Skipping the check of the webhook event types against the abilities of the account: %s", err)
+				return nil
+			}
+		}
+		if !util.Contains(abilities, ability) {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       fmt.Sprintf("The event type %q may require the %q ability", event, ability),
+				Detail:        fmt.Sprintf("The account doesn't have the %q ability, PagerDuty may refuse the webhook subscription or not deliver the %q events.", ability, event),
+				AttributePath: cty.GetAttrPath("events").IndexInt(i),
+			})
+		}
+	}
+	return diags
+}
+
+// validateWebhookSubscriptionFilter checks that the account wide filters don't
+// reference an object, and that the other filter types do.
+func validateWebhookSubscriptionFilter(filterType, id string) error {
+	if filterType == "account_reference" {
+		if id != "" {
+			return fmt.Errorf("a filter of type %q applies to the whole account and must not set an id", filterType)
+		}
+		return nil
+	}
+	if id == "" {
+		return fmt.Errorf("a filter of type %q requires the id of the object to filter the events on", filterType)
+	}
+	return nil
+}
+
 func buildWebhookSubscriptionStruct(d *schema.ResourceData) *pagerduty.WebhookSubscription {
 	webhook := pagerduty.WebhookSubscription{
 		Type:           d.Get("type").(string),
@@ -131,6 +216,15 @@ func buildWebhookSubscriptionStruct(d *schema.ResourceData) *pagerduty.WebhookSu
 	return &webhook
 }
 
+func resourcePagerDutyWebhookSubscriptionCreateContext(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := webhookSubscriptionEventAbilitiesWarnings(meta.(*Config), d.Get("events").([]interface{}))
+
+	if err := resourcePagerDutyWebhookSubscriptionCreate(d, meta); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
 func resourcePagerDutyWebhookSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -158,10 +252,41 @@ func resourcePagerDutyWebhookSubscriptionCreate(d *schema.ResourceData, meta int
 		return retryErr
 	}
 
-	return resourcePagerDutyWebhookSubscriptionRead(d, meta)
+	if isWebhookSubscriptionConfiguredInactive(d) {
+		if _, err := updateWebhookSubscriptionActive(context.Background(), client, d.Id(), false); err != nil {
+			return err
+		}
+	}
+
+	return fetchPagerDutyWebhookSubscription(d, meta)
+}
+
+func resourcePagerDutyWebhookSubscriptionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := fetchPagerDutyWebhookSubscription(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return webhookSubscriptionDisabledDiagnostics(d)
 }
 
-func resourcePagerDutyWebhookSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+// webhookSubscriptionDisabledDiagnostics warns about the subscriptions whose
+// delivery PagerDuty disabled after repeated failures, which would otherwise
+// go unnoticed as nothing in the configuration changes.
+func webhookSubscriptionDisabledDiagnostics(d *schema.ResourceData) diag.Diagnostics {
+	if d.Id() == "" || !d.Get("delivery_method.0.temporarily_disabled").(bool) {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("PagerDuty webhook subscription %s is temporarily disabled", d.Id()),
+			Detail:   fmt.Sprintf("PagerDuty disabled the delivery of the events to %s after repeated failures, no events are delivered until the endpoint is fixed and the subscription is enabled again.", d.Get("delivery_method.0.url").(string)),
+		},
+	}
+}
+
+func fetchPagerDutyWebhookSubscription(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
@@ -184,6 +309,18 @@ func resourcePagerDutyWebhookSubscriptionRead(d *schema.ResourceData, meta inter
 	})
 }
 
+func resourcePagerDutyWebhookSubscriptionUpdateContext(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if d.HasChange("events") {
+		diags = webhookSubscriptionEventAbilitiesWarnings(meta.(*Config), d.Get("events").([]interface{}))
+	}
+
+	if err := resourcePagerDutyWebhookSubscriptionUpdate(d, meta); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
+
 func resourcePagerDutyWebhookSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -196,13 +333,55 @@ func resourcePagerDutyWebhookSubscriptionUpdate(d *schema.ResourceData, meta int
 	webhook, _, err := client.WebhookSubscriptions.Update(d.Id(), whStruct)
 	if err != nil {
 		return err
-	} else if webhook != nil {
+	}
+
+	// The API client drops the active field from its payload when it's false,
+	// so the subscription is paused with a request of its own.
+	if !whStruct.Active {
+		log.Printf("[INFO] Pausing PagerDuty webhook subscription %s", d.Id())
+		webhook, err = updateWebhookSubscriptionActive(context.Background(), client, d.Id(), false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if webhook != nil {
 		setWebhookResourceData(d, webhook)
 	}
 
 	return nil
 }
 
+// isWebhookSubscriptionConfiguredInactive reports whether the configuration
+// explicitly sets active to false, subscriptions not setting it are created
+// active.
+func isWebhookSubscriptionConfiguredInactive(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+
+	active := rawConfig.GetAttr("active")
+	return active.IsKnown() && !active.IsNull() && active.False()
+}
+
+func updateWebhookSubscriptionActive(ctx context.Context, client *pagerduty.Client, id string, active bool) (*pagerduty.WebhookSubscription, error) {
+	payload := map[string]interface{}{
+		"webhook_subscription": map[string]interface{}{
+			"active": active,
+		},
+	}
+
+	var v struct {
+		WebhookSubscription *pagerduty.WebhookSubscription `json:"webhook_subscription"`
+	}
+	if err := putAPIResource(ctx, client, fmt.Sprintf("/webhook_subscriptions/%s", id), payload, &v); err != nil {
+		return nil, err
+	}
+
+	return v.WebhookSubscription, nil
+}
+
 func resourcePagerDutyWebhookSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -278,8 +457,14 @@ func flattenDeliveryMethod(method pagerduty.DeliveryMethod) []map[string]interfa
 
 func flattenFilter(filter pagerduty.Filter) []map[string]interface{} {
 	var filters []map[string]interface{}
+	id := filter.ID
+	// The account wide filters are configured without an id, ignore the one
+	// of the account the API may return for them.
+	if filter.Type == "account_reference" {
+		id = ""
+	}
 	filterMap := map[string]interface{}{
-		"id":   filter.ID,
+		"id":   id,
 		"type": filter.Type,
 	}
 	filters = append(filters, filterMap)
@@ -1,14 +1,21 @@
 package pagerduty
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func init() {
@@ -71,6 +78,259 @@ func TestAccPagerDutyWebhookSubscription_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyWebhookSubscription_Active(t *testing.T) {
+	description := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyWebhookSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyWebhookSubscriptionConfigActive(username, email, escalationPolicy, service, description, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyWebhookSubscriptionExists("pagerduty_webhook_subscription.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "active", "true"),
+					testAccCheckPagerDutyWebhookSubscriptionActive("pagerduty_webhook_subscription.foo", true),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyWebhookSubscriptionConfigActive(username, email, escalationPolicy, service, description, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyWebhookSubscriptionExists("pagerduty_webhook_subscription.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "active", "false"),
+					testAccCheckPagerDutyWebhookSubscriptionActive("pagerduty_webhook_subscription.foo", false),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyWebhookSubscriptionConfigActive(username, email, escalationPolicy, service, description, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyWebhookSubscriptionExists("pagerduty_webhook_subscription.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "active", "true"),
+					testAccCheckPagerDutyWebhookSubscriptionActive("pagerduty_webhook_subscription.foo", true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyWebhookSubscription_FilterScope(t *testing.T) {
+	description := fmt.Sprintf("tf-test-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyWebhookSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyWebhookSubscriptionConfigFilter(username, email, escalationPolicy, service, description, "id = \"PACCOUNT\"\n\t\t\ttype = \"account_reference\""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`filter.0.id: a filter of type "account_reference" applies to the whole account and must not set an id`),
+			},
+			{
+				Config:      testAccCheckPagerDutyWebhookSubscriptionConfigFilter(username, email, escalationPolicy, service, description, `type = "team_reference"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`filter.0.id: a filter of type "team_reference" requires the id of the object to filter the events on`),
+			},
+			{
+				Config: testAccCheckPagerDutyWebhookSubscriptionConfigFilter(username, email, escalationPolicy, service, description, `type = "account_reference"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyWebhookSubscriptionExists("pagerduty_webhook_subscription.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "filter.0.type", "account_reference"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "filter.0.id", ""),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyWebhookSubscriptionConfig(username, email, escalationPolicy, service, description),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyWebhookSubscriptionExists("pagerduty_webhook_subscription.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_webhook_subscription.foo", "filter.0.type", "service_reference"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_webhook_subscription.foo", "filter.0.id", "pagerduty_service.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateWebhookSubscriptionFilter(t *testing.T) {
+	cases := []struct {
+		filterType string
+		id         string
+		expected   string
+	}{
+		{filterType: "account_reference"},
+		{filterType: "account_reference", id: "PACCOUNT", expected: `a filter of type "account_reference" applies to the whole account and must not set an id`},
+		{filterType: "service_reference", id: "PSERVICE"},
+		{filterType: "service_reference", expected: `a filter of type "service_reference" requires the id of the object to filter the events on`},
+		{filterType: "team_reference", id: "PTEAM01"},
+		{filterType: "team_reference", expected: `a filter of type "team_reference" requires the id of the object to filter the events on`},
+	}
+	for _, c := range cases {
+		err := validateWebhookSubscriptionFilter(c.filterType, c.id)
+		if c.expected == "" {
+			if err != nil {
+				t.Errorf("expected a %s filter with id %q to be valid, got %v", c.filterType, c.id, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != c.expected {
+			t.Errorf("expected a %s filter with id %q to fail with %q, got %v", c.filterType, c.id, c.expected, err)
+		}
+	}
+}
+
+func TestFlattenWebhookSubscriptionFilter_AccountReference(t *testing.T) {
+	filters := flattenFilter(pagerduty.Filter{ID: "PACCOUNT", Type: "account_reference"})
+	if len(filters) != 1 || filters[0]["type"] != "account_reference" || filters[0]["id"] != "" {
+		t.Errorf("expected the id of an account_reference filter to be dropped, got %v", filters)
+	}
+
+	filters = flattenFilter(pagerduty.Filter{ID: "PSERVICE", Type: "service_reference"})
+	if len(filters) != 1 || filters[0]["id"] != "PSERVICE" {
+		t.Errorf("expected the id of a service_reference filter to be kept, got %v", filters)
+	}
+}
+
+func TestResourcePagerDutyWebhookSubscriptionUpdate_Inactive(t *testing.T) {
+	var payloads []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPut || r.URL.Path != "/webhook_subscriptions/PWEBHOOK" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var payload struct {
+			WebhookSubscription map[string]interface{} `json:"webhook_subscription"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		payloads = append(payloads, payload.WebhookSubscription)
+
+		active, _ := payload.WebhookSubscription["active"].(bool)
+		fmt.Fprintf(w, `{"webhook_subscription":{"id":"PWEBHOOK","type":"webhook_subscription","active":%t,"delivery_method":{"type":"http_delivery_method","url":"https://example.com"},"events":["incident.triggered"],"filter":{"type":"account_reference"}}}`, active)
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := resourcePagerDutyWebhookSubscription()
+	d := r.TestResourceData()
+	d.SetId("PWEBHOOK")
+	d.Set("active", false)
+	d.Set("events", []interface{}{"incident.triggered"})
+	d.Set("delivery_method", []interface{}{map[string]interface{}{"type": "http_delivery_method", "url": "https://example.com"}})
+	d.Set("filter", []interface{}{map[string]interface{}{"type": "account_reference"}})
+
+	if err := resourcePagerDutyWebhookSubscriptionUpdate(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(payloads) != 2 {
+		t.Fatalf("expected the subscription to be paused with a request of its own, got %d requests", len(payloads))
+	}
+	if active, ok := payloads[1]["active"]; !ok || active != false {
+		t.Errorf("expected active to be sent as false, got %v", payloads[1])
+	}
+	if d.Get("active").(bool) {
+		t.Error("expected active to be false in state")
+	}
+}
+
+func TestWebhookSubscriptionEventAbilitiesWarnings(t *testing.T) {
+	status := http.StatusOK
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/abilities" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		requests++
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			w.Write([]byte(`{"error":{"code":2010,"message":"Access Denied"}}`))
+			return
+		}
+		w.Write([]byte(`{"abilities":["teams","urgencies"]}`))
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		name     string
+		status   int
+		events   []interface{}
+		warnings []string
+	}{
+		{name: "supported", events: []interface{}{"incident.triggered", "incident.resolved"}},
+		{
+			name:     "unsupported",
+			events:   []interface{}{"incident.triggered", "incident.responder.added"},
+			warnings: []string{`The event type "incident.responder.added" may require the "coordinated_responding" ability`},
+		},
+		{name: "abilities not readable", status: http.StatusForbidden, events: []interface{}{"incident.responder.added"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			status = http.StatusOK
+			if c.status != 0 {
+				status = c.status
+			}
+			config := &Config{
+				Token:               "foo",
+				ApiUrlOverride:      server.URL,
+				SkipCredsValidation: true,
+			}
+
+			var warnings []string
+			for _, d := range webhookSubscriptionEventAbilitiesWarnings(config, c.events) {
+				if d.Severity != diag.Warning {
+					t.Errorf("expected only warnings, got %q", d.Summary)
+				}
+				warnings = append(warnings, d.Summary)
+			}
+			if fmt.Sprint(warnings) != fmt.Sprint(c.warnings) {
+				t.Errorf("expected the warnings %q, got %q", c.warnings, warnings)
+			}
+		})
+	}
+
+	status, requests = http.StatusOK, 0
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+	for i := 0; i < 2; i++ {
+		webhookSubscriptionEventAbilitiesWarnings(config, []interface{}{"incident.responder.replied"})
+	}
+	if requests != 1 {
+		t.Errorf("expected the abilities to be requested once, got %d requests", requests)
+	}
+}
+
 func testAccCheckPagerDutyWebhookSubscriptionDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -106,6 +366,38 @@ func testAccCheckPagerDutyWebhookSubscriptionExists(n string) resource.TestCheck
 	}
 }
 
+func testAccCheckPagerDutyWebhookSubscriptionActive(n string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, _ := testAccProvider.Meta().(*Config).Client()
+		found, _, err := client.WebhookSubscriptions.Get(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if found.Active != expected {
+			return fmt.Errorf("Expected webhook subscription %s to have active %t, got %t", rs.Primary.ID, expected, found.Active)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyWebhookSubscriptionConfigActive(username, useremail, escalationPolicy, service, description string, active bool) string {
+	return strings.Replace(
+		testAccCheckPagerDutyWebhookSubscriptionConfig(username, useremail, escalationPolicy, service, description),
+		"active = true", fmt.Sprintf("active = %t", active), 1)
+}
+
+func testAccCheckPagerDutyWebhookSubscriptionConfigFilter(username, useremail, escalationPolicy, service, description, filter string) string {
+	return strings.Replace(
+		testAccCheckPagerDutyWebhookSubscriptionConfig(username, useremail, escalationPolicy, service, description),
+		"id = pagerduty_service.foo.id\n\t\t\ttype = \"service_reference\"", filter, 1)
+}
+
 func testAccCheckPagerDutyWebhookSubscriptionConfig(username, useremail, escalationPolicy, service, description string) string {
 	return fmt.Sprintf(`
 	resource "pagerduty_user" "foo" {
@@ -175,3 +467,54 @@ func testAccCheckPagerDutyWebhookSubscriptionConfig(username, useremail, escalat
 	}
 	`, username, useremail, escalationPolicy, service, description)
 }
+
+func TestResourcePagerDutyWebhookSubscriptionRead_TemporarilyDisabled(t *testing.T) {
+	disabled := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/webhook_subscriptions/PWEBHOOK" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"webhook_subscription": map[string]interface{}{
+				"id":     "PWEBHOOK",
+				"type":   "webhook_subscription",
+				"active": true,
+				"events": []string{"incident.triggered"},
+				"delivery_method": map[string]interface{}{
+					"type":                 "http_delivery_method",
+					"url":                  "https://example.com/receive",
+					"temporarily_disabled": disabled,
+				},
+				"filter": map[string]interface{}{"type": "account_reference"},
+			},
+		})
+	}))
+	defer server.Close()
+
+	config := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	r := resourcePagerDutyWebhookSubscription()
+	d := r.TestResourceData()
+	d.SetId("PWEBHOOK")
+	diags := r.ReadContext(context.Background(), d, config)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !d.Get("delivery_method.0.temporarily_disabled").(bool) {
+		t.Error("expected delivery_method.0.temporarily_disabled to be read from the API")
+	}
+	if len(diags) != 1 || !strings.Contains(diags[0].Summary, "PagerDuty webhook subscription PWEBHOOK is temporarily disabled") || !strings.Contains(diags[0].Detail, "https://example.com/receive") {
+		t.Errorf("expected a warning about the disabled subscription, got %v", diags)
+	}
+
+	disabled = false
+	if diags := r.ReadContext(context.Background(), d, config); len(diags) > 0 {
+		t.Errorf("expected no diagnostics for an enabled subscription, got %v", diags)
+	}
+}
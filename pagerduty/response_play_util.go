@@ -0,0 +1,120 @@
+package pagerduty
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// listResponsePlays returns the response plays of the account. The list is
+// only requested once per provider run and then served from memory, unless
+// refresh is set.
+func (c *Config) listResponsePlays(refresh bool) ([]*pagerduty.ResponsePlay, error) {
+	c = c.root()
+	c.responsePlaysMu.Lock()
+	defer c.responsePlaysMu.Unlock()
+
+	if c.responsePlays != nil && !refresh {
+		return c.responsePlays, nil
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	var responsePlays []*pagerduty.ResponsePlay
+	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+		resp, _, err := client.ResponsePlays.List(&pagerduty.ListResponsePlayOptions{})
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusForbidden) {
+				return retry.NonRetryableError(err)
+			}
+
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+		responsePlays = resp.ResponsePlays
+		return nil
+	})
+	if retryErr != nil {
+		return nil, retryErr
+	}
+
+	if responsePlays == nil {
+		responsePlays = []*pagerduty.ResponsePlay{}
+	}
+	c.responsePlays = responsePlays
+
+	return c.responsePlays, nil
+}
+
+// responsePlayExists reports whether the account has a response play with the
+// ID. The cached list is refreshed once before reporting a missing play, so
+// the plays created earlier in the same run are found.
+func (c *Config) responsePlayExists(id string) (bool, error) {
+	for _, refresh := range []bool{false, true} {
+		responsePlays, err := c.listResponsePlays(refresh)
+		if err != nil {
+			return false, err
+		}
+		for _, p := range responsePlays {
+			if p.ID == id {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// validateServiceResponsePlay checks the response play referenced by a service
+// exists, the API silently ignores unknown plays. The check is skipped when
+// the plays can't be listed, e.g. because the token isn't allowed to.
+func validateServiceResponsePlay(diff *schema.ResourceDiff, meta interface{}) error {
+	config, ok := meta.(*Config)
+	if !ok || !diff.HasChange("response_play") || !diff.NewValueKnown("response_play") {
+		return nil
+	}
+
+	id := diff.Get("response_play").(string)
+	if id == "" || id == "null" {
+		return nil
+	}
+
+	exists, err := config.responsePlayExists(id)
+	if err != nil {
+		log.Printf("[WARN] Skipping the validation of response play %s of PagerDuty service %s: %s", id, diff.Get("name").(string), err)
+		return nil
+	}
+	if !exists {
+		return fmt.Errorf("response_play %q of the service doesn't exist, check the ID of the response play", id)
+	}
+
+	return nil
+}
+
+// planServiceResponsePlayRemoval plans the response play of a service to be
+// cleared once it's removed from the configuration. The attribute is
+// computed, so the plan would otherwise keep the play of the state.
+func planServiceResponsePlayRemoval(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.GetAttr("response_play").IsNull() {
+		return nil
+	}
+
+	if old, _ := diff.GetChange("response_play"); old.(string) == "" {
+		return nil
+	}
+
+	return diff.SetNew("response_play", "")
+}
@@ -0,0 +1,40 @@
+package pagerduty
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestConfigResponsePlayExists(t *testing.T) {
+	var calls int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt64(&calls, 1) == 1 {
+			w.Write([]byte(`{"response_plays":[{"id":"PPLAY01","name":"foo"}],"more":false}`))
+			return
+		}
+		w.Write([]byte(`{"response_plays":[{"id":"PPLAY01","name":"foo"},{"id":"PPLAY02","name":"bar"}],"more":false}`))
+	}))
+	defer server.Close()
+
+	c := &Config{
+		Token:               "foo",
+		ApiUrlOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	if exists, err := c.responsePlayExists("PPLAY01"); err != nil || !exists {
+		t.Fatalf("expected PPLAY01 to exist, got %v, %v", exists, err)
+	}
+	if exists, err := c.responsePlayExists("PPLAY01"); err != nil || !exists || calls != 1 {
+		t.Fatalf("expected PPLAY01 to be found in the cached list, got %v, %v after %d requests", exists, err, calls)
+	}
+	if exists, err := c.responsePlayExists("PPLAY02"); err != nil || !exists || calls != 2 {
+		t.Fatalf("expected PPLAY02 to be found refreshing the list, got %v, %v after %d requests", exists, err, calls)
+	}
+	if exists, err := c.responsePlayExists("PNOPE12"); err != nil || exists {
+		t.Fatalf("expected PNOPE12 not to exist, got %v, %v", exists, err)
+	}
+}
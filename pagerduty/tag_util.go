@@ -0,0 +1,90 @@
+package pagerduty
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// entityTagsSchema is the schema of the `tags` attribute of the entities
+// accepting tag assignments, which manages all of their tags at once.
+var entityTagsSchema = &schema.Schema{
+	Type:     schema.TypeSet,
+	Optional: true,
+	Elem: &schema.Schema{
+		Type: schema.TypeString,
+	},
+}
+
+// buildEntityTagAssignments returns the tag assignments required to change the
+// tags of an entity from old to new, or nil when they don't differ.
+func buildEntityTagAssignments(o, n *schema.Set) *pagerduty.TagAssignments {
+	add := n.Difference(o).List()
+	remove := o.Difference(n).List()
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	assignments := &pagerduty.TagAssignments{}
+	for _, t := range add {
+		assignments.Add = append(assignments.Add, &pagerduty.TagAssignment{Type: "tag_reference", TagID: t.(string)})
+	}
+	for _, t := range remove {
+		assignments.Remove = append(assignments.Remove, &pagerduty.TagAssignment{Type: "tag_reference", TagID: t.(string)})
+	}
+
+	return assignments
+}
+
+// updateEntityTags assigns and unassigns the tags of an entity according to
+// the changes of its `tags` attribute, using a single request.
+func updateEntityTags(client *pagerduty.Client, d *schema.ResourceData, entityType string) error {
+	if !d.HasChange("tags") {
+		return nil
+	}
+
+	o, n := d.GetChange("tags")
+	assignments := buildEntityTagAssignments(o.(*schema.Set), n.(*schema.Set))
+	if assignments == nil {
+		return nil
+	}
+
+	log.Printf("[INFO] Changing tags of PagerDuty %s %s: adding %d, removing %d", entityType, d.Id(), len(assignments.Add), len(assignments.Remove))
+
+	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+		if _, err := client.Tags.Assign(entityType, d.Id(), assignments); err != nil {
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+}
+
+// readEntityTags sets the `tags` attribute of an entity from the tags assigned
+// to it. Tags are only read back when the attribute is in use, so tags managed
+// with pagerduty_tag_assignment don't show up as a diff.
+func readEntityTags(client *pagerduty.Client, d *schema.ResourceData, entityType string) error {
+	if _, ok := d.GetOk("tags"); !ok {
+		return nil
+	}
+
+	resp, _, err := client.Tags.ListTagsForEntity(entityType, d.Id())
+	if err != nil {
+		return err
+	}
+
+	tags := make([]interface{}, 0, len(resp.Tags))
+	for _, t := range resp.Tags {
+		tags = append(tags, t.ID)
+	}
+
+	return d.Set("tags", schema.NewSet(schema.HashString, tags))
+}
@@ -0,0 +1,66 @@
+package pagerduty
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+func TestBuildEntityTagAssignments(t *testing.T) {
+	newSet := func(ids ...string) *schema.Set {
+		items := make([]interface{}, 0, len(ids))
+		for _, id := range ids {
+			items = append(items, id)
+		}
+		return schema.NewSet(schema.HashString, items)
+	}
+	tagIDs := func(assignments []*pagerduty.TagAssignment) []string {
+		ids := []string{}
+		for _, a := range assignments {
+			if a.Type != "tag_reference" {
+				t.Errorf("expected tag assignment type to be tag_reference: got %q", a.Type)
+			}
+			ids = append(ids, a.TagID)
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	cases := []struct {
+		name   string
+		old    *schema.Set
+		new    *schema.Set
+		add    []string
+		remove []string
+		noop   bool
+	}{
+		{name: "no tags", old: newSet(), new: newSet(), noop: true},
+		{name: "unchanged", old: newSet("PTAG001", "PTAG002"), new: newSet("PTAG002", "PTAG001"), noop: true},
+		{name: "add all", old: newSet(), new: newSet("PTAG001", "PTAG002"), add: []string{"PTAG001", "PTAG002"}, remove: []string{}},
+		{name: "remove all", old: newSet("PTAG001", "PTAG002"), new: newSet(), add: []string{}, remove: []string{"PTAG001", "PTAG002"}},
+		{name: "add and remove", old: newSet("PTAG001", "PTAG002"), new: newSet("PTAG002", "PTAG003"), add: []string{"PTAG003"}, remove: []string{"PTAG001"}},
+	}
+
+	for _, tc := range cases {
+		assignments := buildEntityTagAssignments(tc.old, tc.new)
+		if tc.noop {
+			if assignments != nil {
+				t.Errorf("%s: expected no tag assignments: got %d to add and %d to remove", tc.name, len(assignments.Add), len(assignments.Remove))
+			}
+			continue
+		}
+		if assignments == nil {
+			t.Errorf("%s: expected tag assignments: got none", tc.name)
+			continue
+		}
+		if add := tagIDs(assignments.Add); !reflect.DeepEqual(add, tc.add) {
+			t.Errorf("%s: expected tags to add to be %v: got %v", tc.name, tc.add, add)
+		}
+		if remove := tagIDs(assignments.Remove); !reflect.DeepEqual(remove, tc.remove) {
+			t.Errorf("%s: expected tags to remove to be %v: got %v", tc.name, tc.remove, remove)
+		}
+	}
+}
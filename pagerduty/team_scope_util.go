@@ -0,0 +1,87 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// teamRestrictedRole is the role of the users who can only see and act on the
+// objects of the teams they belong to.
+const teamRestrictedRole = "restricted_access"
+
+// tokenTeamScope returns the IDs of the teams the token of the provider is
+// restricted to, or nil when it can see the objects of the whole account. Only
+// the user level tokens of users with restricted access are scoped to teams,
+// the API refuses to tell the user of the other tokens. The scope is only
+// requested once per provider run and then served from memory.
+func (c *Config) tokenTeamScope(ctx context.Context) ([]string, error) {
+	c = c.root()
+	c.teamScopeMu.Lock()
+	defer c.teamScopeMu.Unlock()
+
+	if c.teamScopeFetched {
+		return c.teamScope, nil
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	var v struct {
+		User *pagerduty.User `json:"user"`
+	}
+	err = getAPIResource(ctx, client, "/users/me", nil, &v)
+	if err != nil && !isErrCode(err, http.StatusBadRequest) && !isErrCode(err, http.StatusForbidden) && !isErrCode(err, http.StatusNotFound) {
+		return nil, err
+	}
+
+	var scope []string
+	if err == nil && v.User != nil && v.User.Role == teamRestrictedRole {
+		scope = []string{}
+		for _, t := range v.User.Teams {
+			if t != nil {
+				scope = append(scope, t.ID)
+			}
+		}
+		log.Printf("[INFO] The PagerDuty token is scoped to the teams %s", strings.Join(scope, ", "))
+	}
+
+	c.teamScope = scope
+	c.teamScopeFetched = true
+
+	return c.teamScope, nil
+}
+
+// scopedTeamIDs returns the teams to filter a list on, the teams requested
+// when there are, or else the teams the token is scoped to.
+func (c *Config) scopedTeamIDs(ctx context.Context, requested []string) ([]string, error) {
+	if len(requested) > 0 {
+		return requested, nil
+	}
+	return c.tokenTeamScope(ctx)
+}
+
+// explainTeamScopeError explains the access denied errors of the tokens scoped
+// to teams, which the API returns without telling it's because of the scope.
+func (c *Config) explainTeamScopeError(ctx context.Context, err error, what string) error {
+	if err == nil || !isErrCode(err, http.StatusForbidden) {
+		return err
+	}
+
+	scope, scopeErr := c.tokenTeamScope(ctx)
+	if scopeErr != nil || scope == nil {
+		return err
+	}
+
+	teams := "no team"
+	if len(scope) > 0 {
+		teams = "the teams " + strings.Join(scope, ", ")
+	}
+	return fmt.Errorf("%s is outside of the scope of the PagerDuty token, which belongs to a user with restricted access to %s: %w", what, teams, err)
+}
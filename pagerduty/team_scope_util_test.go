@@ -0,0 +1,123 @@
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newTeamScopedTokenServer serves the API as seen by the user level token of a
+// user with restricted access to the team PTEAM01.
+func newTeamScopedTokenServer(t *testing.T, me string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		teamIDs := strings.Join(r.URL.Query()["team_ids[]"], ",")
+		switch r.URL.Path {
+		case "/users/me":
+			if me == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error":{"code":2001,"message":"Invalid Input Provided","errors":["Requires a user level API token"]}}`))
+				return
+			}
+			w.Write([]byte(me))
+		case "/users":
+			if teamIDs != "PTEAM01" {
+				t.Errorf("expected the users to be filtered on the team of the token, got %q", teamIDs)
+			}
+			w.Write([]byte(`{"users":[{"id":"PUSER01","name":"Earline Greenholt","email":"earline@foo.test"}],"more":false}`))
+		case "/escalation_policies":
+			if me != "" && teamIDs != "PTEAM01" {
+				t.Errorf("expected the escalation policies to be filtered on the team of the token, got %q", teamIDs)
+			}
+			if me == "" && teamIDs != "" {
+				t.Errorf("expected the escalation policies of an account level token not to be filtered, got %q", teamIDs)
+			}
+			w.Write([]byte(`{"escalation_policies":[{"id":"PEP0001","name":"Engineering"}],"more":false}`))
+		case "/teams/PTEAM02/members":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"error":{"code":2010,"message":"Access Denied"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+const teamScopedTokenUser = `{"user":{"id":"PUSER01","role":"restricted_access","teams":[{"id":"PTEAM01","type":"team_reference"}]}}`
+
+func TestConfigTokenTeamScope(t *testing.T) {
+	cases := []struct {
+		name     string
+		me       string
+		expected []string
+	}{
+		{name: "restricted access user", me: teamScopedTokenUser, expected: []string{"PTEAM01"}},
+		{name: "account user", me: `{"user":{"id":"PUSER01","role":"admin","teams":[{"id":"PTEAM01","type":"team_reference"}]}}`},
+		{name: "account level token"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			server := newTeamScopedTokenServer(t, c.me)
+			defer server.Close()
+
+			config := &Config{Token: "foo", ApiUrlOverride: server.URL, SkipCredsValidation: true}
+			scope, err := config.tokenTeamScope(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(scope, ",") != strings.Join(c.expected, ",") || (scope == nil) != (c.expected == nil) {
+				t.Errorf("expected the token to be scoped to %v, got %v", c.expected, scope)
+			}
+		})
+	}
+}
+
+func TestDataSourcesTeamScopedToken(t *testing.T) {
+	server := newTeamScopedTokenServer(t, teamScopedTokenUser)
+	defer server.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: server.URL, SkipCredsValidation: true}
+
+	users := dataSourcePagerDutyUsers()
+	d := users.TestResourceData()
+	if err := users.Read(d, config); err != nil {
+		t.Fatalf("unexpected error reading the users: %v", err)
+	}
+	if got := d.Get("users.#").(int); got != 1 {
+		t.Errorf("expected the user of the team to be found, got %d users", got)
+	}
+
+	policies := dataSourcePagerDutyEscalationPolicies()
+	d = policies.TestResourceData()
+	if err := policies.Read(d, config); err != nil {
+		t.Fatalf("unexpected error reading the escalation policies: %v", err)
+	}
+	if got := d.Get("escalation_policies.#").(int); got != 1 {
+		t.Errorf("expected the escalation policy of the team to be found, got %d escalation policies", got)
+	}
+
+	members := dataSourcePagerDutyTeamMembers()
+	d = members.TestResourceData()
+	d.Set("team_id", "PTEAM02")
+	diags := members.ReadContext(context.Background(), d, config)
+	if !diags.HasError() {
+		t.Fatal("expected an error reading the members of a team outside of the scope of the token")
+	}
+	if expected := "Team PTEAM02 is outside of the scope of the PagerDuty token, which belongs to a user with restricted access to the teams PTEAM01"; !strings.Contains(diags[0].Summary, expected) {
+		t.Errorf("expected the error to explain the scope of the token, got %q", diags[0].Summary)
+	}
+}
+
+func TestDataSourcePagerDutyEscalationPoliciesAccountLevelToken(t *testing.T) {
+	server := newTeamScopedTokenServer(t, "")
+	defer server.Close()
+
+	config := &Config{Token: "foo", ApiUrlOverride: server.URL, SkipCredsValidation: true}
+
+	policies := dataSourcePagerDutyEscalationPolicies()
+	d := policies.TestResourceData()
+	if err := policies.Read(d, config); err != nil {
+		t.Fatalf("unexpected error reading the escalation policies: %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package pagerduty
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const (
+	networkRetryMaxAttempts = 3
+	networkRetryMaxDelay    = 8 * time.Second
+)
+
+// requestIDHeader identifies the API requests, PagerDuty support asks for its
+// value when looking into problems with the API.
+const requestIDHeader = "X-Request-Id"
+
+// retryTransport retries the requests failing because of transient network
+// errors, like connection resets or DNS lookup failures, which are common
+// behind flaky proxies. Retries based on the HTTP status of the responses are
+// handled by the API client instead.
+type retryTransport struct {
+	transport  http.RoundTripper
+	maxRetries int
+	maxDelay   time.Duration
+	stats      *clientStats
+}
+
+func newRetryTransport(transport http.RoundTripper) *retryTransport {
+	return &retryTransport{
+		transport:  transport,
+		maxRetries: networkRetryMaxAttempts,
+		maxDelay:   networkRetryMaxDelay,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.transport.RoundTrip(req)
+		if err == nil || attempt >= t.maxRetries || !isRetryableNetworkError(req.Method, err) || req.Context().Err() != nil {
+			return resp, err
+		}
+
+		// The body of the failed attempt was already consumed, requests
+		// which body can't be rewound can't be retried.
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		delay := calculateNetworkRetryDelay(attempt, t.maxDelay)
+		t.stats.recordRetry(delay)
+		log.Printf("[INFO] Network error calling %s %s, retrying in %v: %s", req.Method, req.URL, delay, err)
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// isRetryableNetworkError reports whether err is a transient network level
+// error, as opposed to errors like a canceled request or an invalid URL, for
+// which the request can be sent again. Requests with a method which isn't
+// idempotent are only retried when they failed before being written to the
+// connection, a reset or a timeout after that could mean the API already
+// handled them.
+func isRetryableNetworkError(method string, err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	if !isIdempotentMethod(method) {
+		return false
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNABORTED) {
+		return true
+	}
+
+	return opErr != nil && opErr.Timeout()
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// calculateNetworkRetryDelay uses the same binary exponential backoff as the
+// retries of the API client.
+func calculateNetworkRetryDelay(attempt int, maxDelay time.Duration) time.Duration {
+	delay := time.Duration(math.Exp2(float64(attempt))) * time.Second
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	return delay
+}
+
+// requestIDTransport appends the ID of the request to the status of the failed
+// API responses, so it's part of the errors the API client builds from them
+// and of the diagnostics of the operations failing because of them.
+type requestIDTransport struct {
+	transport http.RoundTripper
+}
+
+func newRequestIDTransport(transport http.RoundTripper) *requestIDTransport {
+	return &requestIDTransport{transport: transport}
+}
+
+func (t *requestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode < http.StatusBadRequest {
+		return resp, err
+	}
+
+	if id := resp.Header.Get(requestIDHeader); id != "" {
+		resp.Status = fmt.Sprintf("%s (request ID: %s)", resp.Status, id)
+	}
+
+	return resp, nil
+}
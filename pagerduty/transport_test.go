@@ -0,0 +1,119 @@
+package pagerduty
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryTransportConnectionReset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	attempts := 0
+	transport := newRetryTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		if attempts == 1 {
+			io.ReadAll(req.Body)
+			return nil, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	}))
+	transport.maxDelay = time.Millisecond
+
+	client := &http.Client{Transport: transport}
+	req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"foo":"bar"}`))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("expected request to succeed on retry: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+	if body, _ := io.ReadAll(resp.Body); string(body) != `{"foo":"bar"}` {
+		t.Errorf("expected the request body to be sent again on retry, got %q", body)
+	}
+}
+
+func TestRetryTransportConnectionResetNonIdempotent(t *testing.T) {
+	attempts := 0
+	transport := newRetryTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	}))
+	transport.maxDelay = time.Millisecond
+
+	req, _ := http.NewRequest(http.MethodPost, "http://localhost", strings.NewReader(`{"foo":"bar"}`))
+	if _, err := transport.RoundTrip(req); err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 1 {
+		t.Errorf("expected a POST reset after being sent not to be retried, got %d attempts", attempts)
+	}
+}
+
+func TestRetryTransportNonNetworkError(t *testing.T) {
+	attempts := 0
+	transport := newRetryTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return nil, errors.New("unsupported protocol scheme")
+	}))
+	transport.maxDelay = time.Millisecond
+
+	req, _ := http.NewRequest(http.MethodGet, "http://localhost", nil)
+	if _, err := transport.RoundTrip(req); err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 1 {
+		t.Errorf("expected non network errors not to be retried, got %d attempts", attempts)
+	}
+}
+
+func TestIsRetryableNetworkError(t *testing.T) {
+	reset := &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	timeout := &net.OpError{Op: "read", Net: "tcp", Err: os.ErrDeadlineExceeded}
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+	lookup := &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "api.pagerduty.com"}}
+
+	cases := []struct {
+		method   string
+		err      error
+		expected bool
+	}{
+		{http.MethodGet, reset, true},
+		{http.MethodPut, reset, true},
+		{http.MethodDelete, timeout, true},
+		{http.MethodPost, reset, false},
+		{http.MethodPatch, timeout, false},
+		{http.MethodGet, refused, true},
+		{http.MethodPost, refused, true},
+		{http.MethodPost, lookup, true},
+		{http.MethodPatch, &net.DNSError{Err: "no such host", Name: "api.pagerduty.com"}, true},
+		{http.MethodGet, &net.OpError{Op: "write", Net: "tcp", Err: errors.New("use of closed network connection")}, false},
+		{http.MethodGet, errors.New("unsupported protocol scheme"), false},
+	}
+
+	for _, c := range cases {
+		if got := isRetryableNetworkError(c.method, c.err); got != c.expected {
+			t.Errorf("isRetryableNetworkError(%s, %q): expected %v, got %v", c.method, c.err, c.expected, got)
+		}
+	}
+}
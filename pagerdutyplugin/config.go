@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
@@ -42,12 +44,24 @@ type Config struct {
 	// Target version for terraform
 	TerraformVersion string
 
+	// Appended to the user agent of the API client, e.g. to route the
+	// requests through API gateways
+	UserAgentSuffix string
+
 	// Region where the server of the service is deployed
 	ServiceRegion string
 
 	// Do not verify TLS certs for HTTPS requests - useful if you're behind a corporate proxy
 	InsecureTls bool
 
+	// Timeout of establishing the connections to the API, the one of the
+	// default transport is used when zero
+	DialTimeout time.Duration
+
+	// Interval between the keep-alive probes of the connections to the API,
+	// the one of the default transport is used when zero
+	KeepAlive time.Duration
+
 	// Parameters for fine-grained access control
 	AppOauthScopedToken *AppOauthScopedToken
 
@@ -65,6 +79,17 @@ Please see https://www.terraform.io/docs/providers/pagerduty/index.html
 for more information on providing credentials for this provider.
 `
 
+// terraformVersionWithSuffix returns the version of Terraform followed by the
+// UserAgentSuffix when set, the client only allows to set the Terraform
+// version of its user agent.
+func (c *Config) terraformVersionWithSuffix() string {
+	if c.UserAgentSuffix == "" {
+		return c.TerraformVersion
+	}
+
+	return c.TerraformVersion + " " + c.UserAgentSuffix
+}
+
 // Client returns a PagerDuty client, initializing when necessary.
 func (c *Config) Client(ctx context.Context) (*pagerduty.Client, error) {
 	c.mu.Lock()
@@ -82,6 +107,16 @@ func (c *Config) Client(ctx context.Context) (*pagerduty.Client, error) {
 	if c.InsecureTls {
 		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 	}
+	if c.DialTimeout > 0 || c.KeepAlive > 0 {
+		dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+		if c.DialTimeout > 0 {
+			dialer.Timeout = c.DialTimeout
+		}
+		if c.KeepAlive > 0 {
+			dialer.KeepAlive = c.KeepAlive
+		}
+		transport.DialContext = dialer.DialContext
+	}
 	httpClient.Transport = logging.NewTransport("PagerDuty", transport)
 
 	apiURL := c.APIURL
@@ -95,7 +130,7 @@ func (c *Config) Client(ctx context.Context) (*pagerduty.Client, error) {
 	clientOpts := []pagerduty.ClientOptions{
 		WithHTTPClient(httpClient),
 		pagerduty.WithAPIEndpoint(apiURL),
-		pagerduty.WithTerraformProvider(c.TerraformVersion),
+		pagerduty.WithTerraformProvider(c.terraformVersionWithSuffix()),
 		pagerduty.WithRetryPolicy(maxRetries, retryInterval),
 	}
 
@@ -103,7 +138,7 @@ func (c *Config) Client(ctx context.Context) (*pagerduty.Client, error) {
 		tokenFile := getTokenFilepath()
 		account := fmt.Sprintf("as_account-%s.%s", c.ServiceRegion, c.AppOauthScopedToken.Subdomain)
 		accountAndScopes := []string{account}
-		accountAndScopes = append(accountAndScopes, availableOauthScopes()...)
+		accountAndScopes = append(accountAndScopes, util.AvailableOauthScopes()...)
 		opt := pagerduty.WithScopedOAuthAppTokenSource(pagerduty.NewFileTokenSource(
 			ctx,
 			c.AppOauthScopedToken.ClientID,
@@ -155,62 +190,21 @@ func getTokenFilepath() string {
 	return filepath.Join(dir, "token.json")
 }
 
-func availableOauthScopes() []string {
-	return []string{
-		"abilities.read",
-		"addons.read",
-		"addons.write",
-		"analytics.read",
-		"audit_records.read",
-		"change_events.read",
-		"change_events.write",
-		"custom_fields.read",
-		"custom_fields.write",
-		"escalation_policies.read",
-		"escalation_policies.write",
-		"event_orchestrations.read",
-		"event_orchestrations.write",
-		"event_rules.read",
-		"event_rules.write",
-		"extension_schemas.read",
-		"extensions.read",
-		"extensions.write",
-		"incident_workflows.read",
-		"incident_workflows.write",
-		"incident_workflows:instances.write",
-		"incidents.read",
-		"incidents.write",
-		"licenses.read",
-		"notifications.read",
-		"oncalls.read",
-		"priorities.read",
-		"response_plays.read",
-		"response_plays.write",
-		"schedules.read",
-		"schedules.write",
-		"services.read",
-		"services.write",
-		"standards.read",
-		"standards.write",
-		"status_dashboards.read",
-		"status_pages.read",
-		"status_pages.write",
-		"subscribers.read",
-		"subscribers.write",
-		"tags.read",
-		"tags.write",
-		"teams.read",
-		"teams.write",
-		"templates.read",
-		"templates.write",
-		"users.read",
-		"users.write",
-		"users:contact_methods.read",
-		"users:contact_methods.write",
-		"users:sessions.read",
-		"users:sessions.write",
-		"vendors.read",
-	}
+// configuredProviderData is handed by the provider to its resources and data sources,
+// along with the API client it carries the arguments of the provider some of
+// them need.
+type configuredProviderData struct {
+	client *pagerduty.Client
+
+	// The PagerDuty API URL the client sends its requests to
+	apiURL string
+
+	// ID of the team assigned to the resources which don't configure one
+	defaultTeam string
+
+	// Report the resources PagerDuty can't find anymore on refresh instead
+	// of removing them from state
+	strictMissing bool
 }
 
 // ConfigurePagerdutyClient sets a pagerduty API client in a pointer `dst` to
@@ -221,12 +215,12 @@ func ConfigurePagerdutyClient(dst **pagerduty.Client, providerData any) diag.Dia
 	if providerData == nil {
 		return diags
 	}
-	client, ok := providerData.(*pagerduty.Client)
+	data, ok := providerData.(*configuredProviderData)
 	if !ok {
 		diags.AddError(
 			"Unexpected Data Source Configure Type",
 			fmt.Sprintf(
-				"Expected *github.com/PagerDuty/go-pagerduty.Client, got: %T."+
+				"Expected *pagerduty.configuredProviderData, got: %T."+
 					"Please report this issue to the provider developers.",
 				providerData,
 			),
@@ -240,6 +234,31 @@ func ConfigurePagerdutyClient(dst **pagerduty.Client, providerData any) diag.Dia
 		)
 		return diags
 	}
-	*dst = client
+	*dst = data.client
 	return diags
 }
+
+// removeMissingResource removes the resource of a Read from state because
+// PagerDuty reports it as not found. With the `strict_missing` argument of the
+// provider it's reported as an error instead, and its state is kept.
+func removeMissingResource(ctx context.Context, name, id string, strictMissing bool, resp *resource.ReadResponse) {
+	if !strictMissing {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		util.StrictMissingSummary(name, id),
+		util.StrictMissingDetail(name, id),
+	)
+}
+
+// configuredProvider returns the arguments of the provider handed to a
+// resource or data source, which are all empty before the provider is
+// configured.
+func configuredProvider(providerData any) configuredProviderData {
+	if data, ok := providerData.(*configuredProviderData); ok {
+		return *data
+	}
+	return configuredProviderData{}
+}
@@ -2,6 +2,9 @@ package pagerduty
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -79,3 +82,34 @@ func TestConfigInsecureTls(t *testing.T) {
 		t.Fatalf("error: expected the client to not fail: %v", err)
 	}
 }
+
+// Test the user agent suffix is sent by the client
+func TestConfigUserAgentSuffix(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"abilities":[]}`))
+	}))
+	defer server.Close()
+
+	config := Config{
+		Token:               "foo",
+		TerraformVersion:    "1.5.0",
+		UserAgentSuffix:     "acme-gateway/1.0",
+		APIURLOverride:      server.URL,
+		SkipCredsValidation: true,
+	}
+
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("error: expected the client to not fail: %v", err)
+	}
+	if _, err := client.ListAbilitiesWithContext(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasSuffix(userAgent, "Terraform/1.5.0 acme-gateway/1.0") {
+		t.Errorf("expected the User-Agent to end with the suffix, got %q", userAgent)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
@@ -26,9 +27,15 @@ func (*dataSourceBusinessService) Metadata(_ context.Context, _ datasource.Metad
 func (*dataSourceBusinessService) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
-			"id":   schema.StringAttribute{Computed: true},
-			"name": schema.StringAttribute{Required: true},
-			"type": schema.StringAttribute{Computed: true},
+			"id":               schema.StringAttribute{Computed: true},
+			"name":             schema.StringAttribute{Required: true},
+			"type":             schema.StringAttribute{Computed: true},
+			"description":      schema.StringAttribute{Computed: true},
+			"point_of_contact": schema.StringAttribute{Computed: true},
+			"team": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the team owning the business service",
+			},
 		},
 	}
 }
@@ -46,7 +53,7 @@ func (d *dataSourceBusinessService) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	var found *pagerduty.BusinessService
+	var found []*pagerduty.BusinessService
 	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
 		list, err := d.client.ListBusinessServices(pagerduty.ListBusinessServiceOptions{})
 		if err != nil {
@@ -56,10 +63,10 @@ func (d *dataSourceBusinessService) Read(ctx context.Context, req datasource.Rea
 			return retry.RetryableError(err)
 		}
 
+		found = nil
 		for _, bs := range list.BusinessServices {
 			if bs.Name == searchName.ValueString() {
-				found = bs
-				break
+				found = append(found, bs)
 			}
 		}
 		return nil
@@ -69,26 +76,52 @@ func (d *dataSourceBusinessService) Read(ctx context.Context, req datasource.Rea
 			fmt.Sprintf("Error reading Business Service %s", searchName),
 			err.Error(),
 		)
+		return
 	}
 
-	if found == nil {
+	if len(found) == 0 {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to locate any business service with the name: %s", searchName),
 			"",
 		)
 		return
 	}
-
-	model := dataSourceBusinessServiceModel{
-		ID:   types.StringValue(found.ID),
-		Name: types.StringValue(found.Name),
-		Type: types.StringValue(found.Type),
+	if len(found) > 1 {
+		ids := make([]string, 0, len(found))
+		for _, bs := range found {
+			ids = append(ids, bs.ID)
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Found %d business services with the name: %s", len(found), searchName),
+			fmt.Sprintf("Business services %s share this name, rename them or reference the right one by its ID.", strings.Join(ids, ", ")),
+		)
+		return
 	}
+
+	model := flattenDataSourceBusinessService(found[0])
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
 type dataSourceBusinessServiceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
-	Type types.String `tfsdk:"type"`
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Description    types.String `tfsdk:"description"`
+	PointOfContact types.String `tfsdk:"point_of_contact"`
+	Team           types.String `tfsdk:"team"`
+}
+
+func flattenDataSourceBusinessService(bs *pagerduty.BusinessService) dataSourceBusinessServiceModel {
+	model := dataSourceBusinessServiceModel{
+		ID:             types.StringValue(bs.ID),
+		Name:           types.StringValue(bs.Name),
+		Type:           types.StringValue(bs.Type),
+		Description:    types.StringValue(bs.Description),
+		PointOfContact: types.StringValue(bs.PointOfContact),
+		Team:           types.StringNull(),
+	}
+	if bs.Team != nil && bs.Team.ID != "" {
+		model.Team = types.StringValue(bs.Team.ID)
+	}
+	return model
 }
@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -26,6 +27,40 @@ func TestAccDataSourcePagerDutyBusinessService_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyBusinessService_NotFound(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "pagerduty_business_service" "by_name" {
+  name = "%s"
+}
+`, name),
+				ExpectError: regexp.MustCompile("Unable to locate any business service with the name"),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePagerDutyBusinessService_Duplicated(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourcePagerDutyBusinessServiceDuplicatedConfig(name),
+				ExpectError: regexp.MustCompile("Found 2 business services with the name"),
+			},
+		},
+	})
+}
+
 func testAccDataSourcePagerDutyBusinessService(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		srcR := s.RootModule().Resources[src]
@@ -38,7 +73,7 @@ func testAccDataSourcePagerDutyBusinessService(src, n string) resource.TestCheck
 			return fmt.Errorf("Expected to get a business service ID from PagerDuty")
 		}
 
-		testAtts := []string{"id", "name"}
+		testAtts := []string{"id", "name", "type", "description", "point_of_contact", "team"}
 
 		for _, att := range testAtts {
 			if a[att] != srcA[att] {
@@ -52,8 +87,15 @@ func testAccDataSourcePagerDutyBusinessService(src, n string) resource.TestCheck
 
 func testAccDataSourcePagerDutyBusinessServiceConfig(name string) string {
 	return fmt.Sprintf(`
+resource "pagerduty_team" "test" {
+  name = "%[1]s"
+}
+
 resource "pagerduty_business_service" "test" {
-  name = "%s"
+  name             = "%[1]s"
+  description      = "%[1]s description"
+  point_of_contact = "%[1]s contact"
+  team             = pagerduty_team.test.id
 }
 
 data "pagerduty_business_service" "by_name" {
@@ -61,3 +103,20 @@ data "pagerduty_business_service" "by_name" {
 }
 `, name)
 }
+
+func testAccDataSourcePagerDutyBusinessServiceDuplicatedConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_business_service" "first" {
+  name = "%[1]s"
+}
+
+resource "pagerduty_business_service" "second" {
+  name = "%[1]s"
+}
+
+data "pagerduty_business_service" "by_name" {
+  name       = "%[1]s"
+  depends_on = [pagerduty_business_service.first, pagerduty_business_service.second]
+}
+`, name)
+}
@@ -0,0 +1,109 @@
+package pagerduty
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
+)
+
+type dataSourceBusinessServices struct{ client *pagerduty.Client }
+
+var _ datasource.DataSourceWithConfigure = (*dataSourceBusinessServices)(nil)
+
+func (*dataSourceBusinessServices) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "pagerduty_business_services"
+}
+
+func (*dataSourceBusinessServices) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true},
+			"business_services": schema.ListAttribute{
+				Computed:    true,
+				ElementType: businessServiceObjectType,
+			},
+		},
+	}
+}
+
+func (d *dataSourceBusinessServices) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&d.client, req.ProviderData)...)
+}
+
+func (d *dataSourceBusinessServices) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	log.Println("[INFO] Reading PagerDuty business services")
+
+	var list []*pagerduty.BusinessService
+	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		var err error
+		list, err = d.client.ListBusinessServicesPaginated(ctx, pagerduty.ListBusinessServiceOptions{})
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading PagerDuty business services",
+			err.Error(),
+		)
+		return
+	}
+
+	model := dataSourceBusinessServicesModel{
+		ID:               types.StringValue(id.UniqueId()),
+		BusinessServices: flattenBusinessServices(list, &resp.Diagnostics),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+type dataSourceBusinessServicesModel struct {
+	ID               types.String `tfsdk:"id"`
+	BusinessServices types.List   `tfsdk:"business_services"`
+}
+
+func flattenBusinessServices(list []*pagerduty.BusinessService, diags *diag.Diagnostics) types.List {
+	elements := make([]attr.Value, 0, len(list))
+	for _, bs := range list {
+		model := flattenDataSourceBusinessService(bs)
+		e, d := types.ObjectValue(businessServiceObjectType.AttrTypes, map[string]attr.Value{
+			"id":               model.ID,
+			"name":             model.Name,
+			"type":             model.Type,
+			"description":      model.Description,
+			"point_of_contact": model.PointOfContact,
+			"team":             model.Team,
+		})
+		diags.Append(d...)
+		if d.HasError() {
+			continue
+		}
+		elements = append(elements, e)
+	}
+
+	return types.ListValueMust(businessServiceObjectType, elements)
+}
+
+var businessServiceObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":               types.StringType,
+		"name":             types.StringType,
+		"type":             types.StringType,
+		"description":      types.StringType,
+		"point_of_contact": types.StringType,
+		"team":             types.StringType,
+	},
+}
@@ -0,0 +1,79 @@
+package pagerduty
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDataSourcePagerDutyBusinessServices_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyBusinessServicesConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_business_services.all", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pagerduty_business_services.all", "business_services.*", map[string]string{
+						"name":             name,
+						"type":             "business_service",
+						"description":      name + " description",
+						"point_of_contact": name + " contact",
+					}),
+					testAccDataSourcePagerDutyBusinessServicesHasTeam("pagerduty_business_service.test", "pagerduty_team.test", "data.pagerduty_business_services.all"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyBusinessServicesHasTeam(src, team, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		srcID := s.RootModule().Resources[src].Primary.ID
+		teamID := s.RootModule().Resources[team].Primary.ID
+		a := s.RootModule().Resources[n].Primary.Attributes
+
+		count, err := strconv.Atoi(a["business_services.#"])
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			if a[fmt.Sprintf("business_services.%d.id", i)] != srcID {
+				continue
+			}
+			if got := a[fmt.Sprintf("business_services.%d.team", i)]; got != teamID {
+				return fmt.Errorf("Expected the business service team to be: %s, but got: %s", teamID, got)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("Expected to find the business service %s in %s", srcID, n)
+	}
+}
+
+func testAccDataSourcePagerDutyBusinessServicesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "test" {
+  name = "%[1]s"
+}
+
+resource "pagerduty_business_service" "test" {
+  name             = "%[1]s"
+  description      = "%[1]s description"
+  point_of_contact = "%[1]s contact"
+  team             = pagerduty_team.test.id
+}
+
+data "pagerduty_business_services" "all" {
+  depends_on = [pagerduty_business_service.test]
+}
+`, name)
+}
@@ -34,6 +34,8 @@ func (d *dataSourceService) Schema(ctx context.Context, req datasource.SchemaReq
 			"description":             schema.StringAttribute{Computed: true},
 			"escalation_policy":       schema.StringAttribute{Computed: true},
 			"type":                    schema.StringAttribute{Computed: true},
+			"created_at":              schema.StringAttribute{Computed: true},
+			"last_incident_timestamp": schema.StringAttribute{Computed: true},
 			"teams": schema.ListAttribute{
 				Computed:    true,
 				Description: "The set of teams associated with the service",
@@ -44,10 +46,24 @@ func (d *dataSourceService) Schema(ctx context.Context, req datasource.SchemaReq
 					},
 				},
 			},
+			"integrations": schema.ListAttribute{
+				Computed:    true,
+				Description: "The list of integrations of the service",
+				ElementType: serviceIntegrationObjectType,
+			},
 		},
 	}
 }
 
+var serviceIntegrationObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":     types.StringType,
+		"name":   types.StringType,
+		"type":   types.StringType,
+		"vendor": types.StringType,
+	},
+}
+
 func (d *dataSourceService) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&d.client, req.ProviderData)...)
 }
@@ -67,7 +83,7 @@ func (d *dataSourceService) Read(ctx context.Context, req datasource.ReadRequest
 			Query:    searchName.ValueString(),
 			Limit:    apiutil.Limit,
 			Offset:   uint(offset),
-			Includes: []string{"teams"},
+			Includes: []string{"teams", "integrations"},
 		})
 		if err != nil {
 			return false, err
@@ -114,7 +130,10 @@ type dataSourceServiceModel struct {
 	Description            types.String `tfsdk:"description"`
 	EscalationPolicy       types.String `tfsdk:"escalation_policy"`
 	Type                   types.String `tfsdk:"type"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	LastIncidentTimestamp  types.String `tfsdk:"last_incident_timestamp"`
 	Teams                  types.List   `tfsdk:"teams"`
+	Integrations           types.List   `tfsdk:"integrations"`
 }
 
 func flattenServiceData(service *pagerduty.Service, diags *diag.Diagnostics) dataSourceServiceModel {
@@ -139,16 +158,39 @@ func flattenServiceData(service *pagerduty.Service, diags *diag.Diagnostics) dat
 		return dataSourceServiceModel{}
 	}
 
+	integrationsElems := make([]attr.Value, 0, len(service.Integrations))
+	for _, i := range service.Integrations {
+		vendor := types.StringNull()
+		if i.Vendor != nil {
+			vendor = types.StringValue(i.Vendor.ID)
+		}
+		integrationObj := types.ObjectValueMust(serviceIntegrationObjectType.AttrTypes, map[string]attr.Value{
+			"id":     types.StringValue(i.ID),
+			"name":   types.StringValue(i.Name),
+			"type":   types.StringValue(i.Type),
+			"vendor": vendor,
+		})
+		integrationsElems = append(integrationsElems, integrationObj)
+	}
+
+	integrations, d := types.ListValue(serviceIntegrationObjectType, integrationsElems)
+	if diags.Append(d...); d.HasError() {
+		return dataSourceServiceModel{}
+	}
+
 	model := dataSourceServiceModel{
 		ID:                     types.StringValue(service.ID),
 		Name:                   types.StringValue(service.Name),
 		Type:                   types.StringValue(service.Type),
+		CreatedAt:              types.StringValue(service.CreateAt),
+		LastIncidentTimestamp:  types.StringValue(service.LastIncidentTimestamp),
 		AutoResolveTimeout:     types.Int64Null(),
 		AcknowledgementTimeout: types.Int64Null(),
 		AlertCreation:          types.StringValue(service.AlertCreation),
 		Description:            types.StringValue(service.Description),
 		EscalationPolicy:       types.StringValue(service.EscalationPolicy.ID),
 		Teams:                  teams,
+		Integrations:           integrations,
 	}
 
 	if service.AutoResolveTimeout != nil {
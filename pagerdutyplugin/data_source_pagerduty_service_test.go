@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -24,6 +26,7 @@ func TestAccDataSourcePagerDutyService_Basic(t *testing.T) {
 				Config: testAccDataSourcePagerDutyServiceConfig(username, email, service, escalationPolicy, teamname),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourcePagerDutyService("pagerduty_service.no_team_service", "data.pagerduty_service.no_team_service"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_service.no_team_service", "created_at"),
 				),
 			},
 		},
@@ -73,6 +76,78 @@ func TestAccDataSourcePagerDutyService_HasOneTeam(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyService_EscalationPolicy(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	teamname := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyServiceConfig(username, email, service, escalationPolicy, teamname),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.pagerduty_service.no_team_service", "escalation_policy", "pagerduty_escalation_policy.no_team_ep", "id"),
+					resource.TestCheckResourceAttrPair("data.pagerduty_service.one_team_service", "escalation_policy", "pagerduty_escalation_policy.one_team_ep", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePagerDutyService_Integrations(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyServiceIntegrationsConfig(username, email, service, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_service.test", "integrations.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pagerduty_service.test", "integrations.*", map[string]string{
+						"name": "events",
+						"type": "events_api_v2_inbound_integration",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_service.test", "integrations.*.id", "pagerduty_service_integration.events", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pagerduty_service.test", "integrations.*", map[string]string{
+						"name": "datadog",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.pagerduty_service.test", "integrations.*.vendor", "data.pagerduty_vendor.datadog", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenServiceData_Timestamps(t *testing.T) {
+	var diags diag.Diagnostics
+	model := flattenServiceData(&pagerduty.Service{
+		APIObject:             pagerduty.APIObject{ID: "PSVC123", Type: "service"},
+		Name:                  "foo",
+		EscalationPolicy:      pagerduty.EscalationPolicy{APIObject: pagerduty.APIObject{ID: "PEP1234"}},
+		CreateAt:              "2024-01-02T03:04:05Z",
+		LastIncidentTimestamp: "2024-02-03T04:05:06Z",
+	}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := model.CreatedAt.ValueString(); got != "2024-01-02T03:04:05Z" {
+		t.Errorf("expected created_at to be set from the service, got %q", got)
+	}
+	if got := model.LastIncidentTimestamp.ValueString(); got != "2024-02-03T04:05:06Z" {
+		t.Errorf("expected last_incident_timestamp to be set from the service, got %q", got)
+	}
+}
+
 func testAccDataSourcePagerDutyService(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		srcR := s.RootModule().Resources[src]
@@ -85,7 +160,7 @@ func testAccDataSourcePagerDutyService(src, n string) resource.TestCheckFunc {
 			return fmt.Errorf("Expected to get a service ID from PagerDuty")
 		}
 
-		testAtts := []string{"id", "name", "type", "auto_resolve_timeout", "acknowledgement_timeout", "alert_creation", "description", "escalation_policy"}
+		testAtts := []string{"id", "name", "type", "auto_resolve_timeout", "acknowledgement_timeout", "alert_creation", "description", "escalation_policy", "created_at", "last_incident_timestamp"}
 
 		for _, att := range testAtts {
 			if a[att] != srcA[att] {
@@ -164,3 +239,52 @@ data "pagerduty_service" "one_team_service" {
 
 `, teamname, username, email, service, escalationPolicy)
 }
+
+func testAccDataSourcePagerDutyServiceIntegrationsConfig(username, email, service, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "test" {
+  name      = "%s"
+  num_loops = 2
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.test.id
+    }
+  }
+}
+
+resource "pagerduty_service" "test" {
+  name                    = "%s"
+  auto_resolve_timeout    = 14400
+  acknowledgement_timeout = 600
+  escalation_policy       = pagerduty_escalation_policy.test.id
+}
+
+data "pagerduty_vendor" "datadog" {
+  name = "datadog"
+}
+
+resource "pagerduty_service_integration" "events" {
+  name    = "events"
+  type    = "events_api_v2_inbound_integration"
+  service = pagerduty_service.test.id
+}
+
+resource "pagerduty_service_integration" "datadog" {
+  name    = "datadog"
+  vendor  = data.pagerduty_vendor.datadog.id
+  service = pagerduty_service.test.id
+}
+
+data "pagerduty_service" "test" {
+  depends_on = [pagerduty_service_integration.events, pagerduty_service_integration.datadog]
+  name       = pagerduty_service.test.name
+}
+`, username, email, escalationPolicy, service)
+}
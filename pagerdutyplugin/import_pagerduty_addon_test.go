@@ -0,0 +1,34 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPagerDutyAddon_import(t *testing.T) {
+	addon := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyAddonDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyAddonConfig(addon),
+			},
+			{
+				ResourceName:      "pagerduty_addon.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// The imported addon doesn't need any change
+			{
+				Config:   testAccCheckPagerDutyAddonConfig(addon),
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -56,3 +56,26 @@ func TestAccPagerDutyExtension_import_WithoutEndpointURL(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPagerDutyExtension_import_WithEventTypes(t *testing.T) {
+	extensionName := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	url := "https://example.com/receive_a_pagerduty_webhook"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyExtensionConfig_EventTypes(name, extensionName, url, `["acknowledge", "resolve"]`, "null"),
+			},
+			{
+				ResourceName:            "pagerduty_extension.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -43,6 +44,13 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 			"token":                       schema.StringAttribute{Optional: true},
 			"user_token":                  schema.StringAttribute{Optional: true},
 			"insecure_tls":                schema.BoolAttribute{Optional: true},
+			"validate_conditions":         schema.BoolAttribute{Optional: true},
+			"strict_missing":              schema.BoolAttribute{Optional: true},
+			"dial_timeout_seconds":        schema.Int64Attribute{Optional: true},
+			"keepalive_seconds":           schema.Int64Attribute{Optional: true},
+			"default_team":                schema.StringAttribute{Optional: true},
+			"user_agent_suffix":           schema.StringAttribute{Optional: true},
+			"endpoint_overrides":          schema.MapAttribute{Optional: true, ElementType: types.StringType},
 		},
 		Blocks: map[string]schema.Block{
 			"use_app_oauth_scoped_token": useAppOauthScopedTokenBlock,
@@ -53,6 +61,7 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 func (p *Provider) DataSources(_ context.Context) [](func() datasource.DataSource) {
 	return [](func() datasource.DataSource){
 		func() datasource.DataSource { return &dataSourceBusinessService{} },
+		func() datasource.DataSource { return &dataSourceBusinessServices{} },
 		func() datasource.DataSource { return &dataSourceExtensionSchema{} },
 		func() datasource.DataSource { return &dataSourceIntegration{} },
 		func() datasource.DataSource { return &dataSourceLicense{} },
@@ -73,6 +82,7 @@ func (p *Provider) Resources(_ context.Context) [](func() resource.Resource) {
 		func() resource.Resource { return &resourceExtensionServiceNow{} },
 		func() resource.Resource { return &resourceExtension{} },
 		func() resource.Resource { return &resourceServiceDependency{} },
+		func() resource.Resource { return &resourceStandardsExclusion{} },
 		func() resource.Resource { return &resourceTagAssignment{} },
 		func() resource.Resource { return &resourceTag{} },
 		func() resource.Resource { return &resourceTeam{} },
@@ -118,6 +128,9 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		APIURLOverride:      args.APIURLOverride.ValueString(),
 		ServiceRegion:       serviceRegion,
 		InsecureTls:         insecureTls,
+		DialTimeout:         time.Duration(args.DialTimeoutSeconds.ValueInt64()) * time.Second,
+		KeepAlive:           time.Duration(args.KeepaliveSeconds.ValueInt64()) * time.Second,
+		UserAgentSuffix:     args.UserAgentSuffix.ValueString(),
 	}
 
 	if !args.UseAppOauthScopedToken.IsNull() {
@@ -184,8 +197,18 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		resp.Diagnostics.AddError("Cannot obtain plugin client", err.Error())
 	}
 	p.client = client
-	resp.DataSourceData = client
-	resp.ResourceData = client
+
+	data := &configuredProviderData{
+		client:        client,
+		apiURL:        config.APIURL,
+		defaultTeam:   args.DefaultTeam.ValueString(),
+		strictMissing: args.StrictMissing.ValueBool(),
+	}
+	if config.APIURLOverride != "" {
+		data.apiURL = config.APIURLOverride
+	}
+	resp.DataSourceData = data
+	resp.ResourceData = data
 }
 
 type UseAppOauthScopedToken struct {
@@ -202,6 +225,13 @@ type providerArguments struct {
 	APIURLOverride            types.String `tfsdk:"api_url_override"`
 	UseAppOauthScopedToken    types.List   `tfsdk:"use_app_oauth_scoped_token"`
 	InsecureTls               types.Bool   `tfsdk:"insecure_tls"`
+	ValidateConditions        types.Bool   `tfsdk:"validate_conditions"`
+	StrictMissing             types.Bool   `tfsdk:"strict_missing"`
+	DialTimeoutSeconds        types.Int64  `tfsdk:"dial_timeout_seconds"`
+	KeepaliveSeconds          types.Int64  `tfsdk:"keepalive_seconds"`
+	DefaultTeam               types.String `tfsdk:"default_team"`
+	UserAgentSuffix           types.String `tfsdk:"user_agent_suffix"`
+	EndpointOverrides         types.Map    `tfsdk:"endpoint_overrides"`
 }
 
 type SchemaGetter interface {
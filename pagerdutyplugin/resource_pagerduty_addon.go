@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,7 +17,10 @@ import (
 	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
-type resourceAddon struct{ client *pagerduty.Client }
+type resourceAddon struct {
+	client        *pagerduty.Client
+	strictMissing bool
+}
 
 var (
 	_ resource.Resource                = (*resourceAddon)(nil)
@@ -60,7 +62,14 @@ func (r *resourceAddon) Create(ctx context.Context, req resource.CreateRequest,
 		)
 		return
 	}
-	model = requestGetAddon(ctx, r.client, addonResp.ID, nil, &resp.Diagnostics)
+	model, err = requestGetAddon(ctx, r.client, addonResp.ID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading addon %s", addonResp.ID),
+			err.Error(),
+		)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -72,13 +81,24 @@ func (r *resourceAddon) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 	log.Printf("[INFO] Reading PagerDuty add-on %s", id)
 
-	removeNotFound := func(err error) *retry.RetryError {
+	stopNotFound := func(err error) *retry.RetryError {
 		if util.IsNotFoundError(err) {
-			resp.State.RemoveResource(ctx)
+			return retry.NonRetryableError(err)
 		}
 		return retry.RetryableError(err)
 	}
-	model := requestGetAddon(ctx, r.client, id.ValueString(), removeNotFound, &resp.Diagnostics)
+	model, err := requestGetAddon(ctx, r.client, id.ValueString(), stopNotFound)
+	if err != nil {
+		if util.IsNotFoundError(err) {
+			removeMissingResource(ctx, "pagerduty_addon", id.ValueString(), r.strictMissing, resp)
+			return
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading addon %s", id),
+			err.Error(),
+		)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -134,6 +154,7 @@ func (r *resourceAddon) Delete(ctx context.Context, req resource.DeleteRequest,
 
 func (r *resourceAddon) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	r.strictMissing = configuredProvider(req.ProviderData).strictMissing
 }
 
 func (r *resourceAddon) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -146,7 +167,7 @@ type resourceAddonModel struct {
 	Source types.String `tfsdk:"src"`
 }
 
-func requestGetAddon(ctx context.Context, client *pagerduty.Client, id string, handleErr func(error) *retry.RetryError, diags *diag.Diagnostics) resourceAddonModel {
+func requestGetAddon(ctx context.Context, client *pagerduty.Client, id string, handleErr func(error) *retry.RetryError) (resourceAddonModel, error) {
 	var addon *pagerduty.Addon
 	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
 		var err error
@@ -163,14 +184,9 @@ func requestGetAddon(ctx context.Context, client *pagerduty.Client, id string, h
 		return nil
 	})
 	if err != nil {
-		diags.AddError(
-			fmt.Sprintf("Error reading addon %s", id),
-			err.Error(),
-		)
-		return resourceAddonModel{}
+		return resourceAddonModel{}, err
 	}
-	model := flattenAddon(addon)
-	return model
+	return flattenAddon(addon), nil
 }
 
 func buildAddon(model resourceAddonModel) pagerduty.Addon {
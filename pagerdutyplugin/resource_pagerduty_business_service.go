@@ -22,7 +22,9 @@ import (
 )
 
 type resourceBusinessService struct {
-	client *pagerduty.Client
+	client        *pagerduty.Client
+	defaultTeam   string
+	strictMissing bool
 }
 
 var (
@@ -71,6 +73,7 @@ func (r *resourceBusinessService) Create(ctx context.Context, req resource.Creat
 		return
 	}
 	businessServicePlan := buildPagerdutyBusinessService(&plan)
+	r.applyDefaultTeam(businessServicePlan)
 	log.Printf("[INFO] Creating PagerDuty business service %s", plan.Name)
 
 	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
@@ -90,10 +93,12 @@ func (r *resourceBusinessService) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	team := plan.Team
 	plan, _ = requestGetBusinessService(ctx, r.client, businessServicePlan.ID, true, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	r.omitDefaultTeam(&plan, team)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -106,13 +111,16 @@ func (r *resourceBusinessService) Read(ctx context.Context, req resource.ReadReq
 	}
 	log.Printf("[INFO] Reading PagerDuty business service %s", state.ID)
 
-	state, found := requestGetBusinessService(ctx, r.client, state.ID.ValueString(), false, &resp.Diagnostics)
+	team, id := state.Team, state.ID.ValueString()
+	state, found := requestGetBusinessService(ctx, r.client, id, false, &resp.Diagnostics)
+	if !found {
+		removeMissingResource(ctx, "pagerduty_business_service", id, r.strictMissing, resp)
+		return
+	}
 	if resp.Diagnostics.HasError() {
-		if !found {
-			resp.State.RemoveResource(ctx)
-		}
 		return
 	}
+	r.omitDefaultTeam(&state, team)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -130,6 +138,7 @@ func (r *resourceBusinessService) Update(ctx context.Context, req resource.Updat
 		req.State.GetAttribute(ctx, path.Root("id"), &id)
 		businessServicePlan.ID = id
 	}
+	r.applyDefaultTeam(businessServicePlan)
 	log.Printf("[INFO] Updating PagerDuty business service %s", businessServicePlan.ID)
 
 	businessService, err := r.client.UpdateBusinessServiceWithContext(ctx, businessServicePlan)
@@ -140,7 +149,9 @@ func (r *resourceBusinessService) Update(ctx context.Context, req resource.Updat
 		)
 		return
 	}
+	team := plan.Team
 	plan = flattenBusinessService(businessService)
+	r.omitDefaultTeam(&plan, team)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
@@ -167,6 +178,25 @@ func (r *resourceBusinessService) Delete(ctx context.Context, req resource.Delet
 
 func (r *resourceBusinessService) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	r.strictMissing = configuredProvider(req.ProviderData).strictMissing
+	r.defaultTeam = configuredProvider(req.ProviderData).defaultTeam
+}
+
+// applyDefaultTeam assigns the default team of the provider to a business
+// service which doesn't configure a team.
+func (r *resourceBusinessService) applyDefaultTeam(businessService *pagerduty.BusinessService) {
+	if r.defaultTeam != "" && (businessService.Team == nil || businessService.Team.ID == "") {
+		businessService.Team = &pagerduty.BusinessServiceTeam{ID: r.defaultTeam}
+	}
+}
+
+// omitDefaultTeam leaves the default team of the provider out of the business
+// service read from the API when it was assigned by applyDefaultTeam, i.e. the
+// team it had before is null, so it doesn't show a diff for it.
+func (r *resourceBusinessService) omitDefaultTeam(model *resourceBusinessServiceModel, team types.String) {
+	if r.defaultTeam != "" && team.IsNull() && model.Team.ValueString() == r.defaultTeam {
+		model.Team = types.StringNull()
+	}
 }
 
 func (r *resourceBusinessService) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -244,7 +274,7 @@ func flattenBusinessService(src *pagerduty.BusinessService) resourceBusinessServ
 	if src.PointOfContact != "" {
 		model.PointOfContact = types.StringValue(src.PointOfContact)
 	}
-	if src.Team != nil {
+	if src.Team != nil && src.Team.ID != "" {
 		model.Team = types.StringValue(src.Team.ID)
 	}
 	return model
@@ -3,8 +3,16 @@ package pagerduty
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/PagerDuty/go-pagerduty"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -69,8 +77,14 @@ func TestAccPagerDutyBusinessService_WithTeam(t *testing.T) {
 					resource.TestCheckResourceAttr("pagerduty_business_service.bar", "description", description),
 					resource.TestCheckResourceAttr("pagerduty_business_service.bar", "point_of_contact", pointOfContact),
 					resource.TestCheckResourceAttrSet("pagerduty_business_service.bar", "self"),
+					resource.TestCheckResourceAttrPair("pagerduty_business_service.bar", "team", "pagerduty_team.bar", "id"),
 				),
 			},
+			{
+				ResourceName:      "pagerduty_business_service.bar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -106,6 +120,65 @@ func TestAccPagerDutyBusinessService_SDKv2Compatibility(t *testing.T) {
 	})
 }
 
+func TestResourcePagerDutyBusinessServiceCreate_DefaultTeam(t *testing.T) {
+	const businessService = `{"business_service":{"id":"PBS0001","name":"foo","description":"Managed by Terraform","type":"business_service","team":{"id":"PTEAM01"}}}`
+	var created string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/business_services":
+			body, _ := io.ReadAll(r.Body)
+			created = string(body)
+			w.Write([]byte(businessService))
+		case r.Method == http.MethodGet && r.URL.Path == "/business_services/PBS0001":
+			w.Write([]byte(businessService))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &resourceBusinessService{
+		client:      pagerduty.NewClient("foo", pagerduty.WithAPIEndpoint(server.URL)),
+		defaultTeam: "PTEAM01",
+	}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &resourceBusinessServiceModel{
+		ID:             types.StringUnknown(),
+		Name:           types.StringValue("foo"),
+		Description:    types.StringValue("Managed by Terraform"),
+		Type:           types.StringValue("business_service"),
+		HTMLUrl:        types.StringUnknown(),
+		Self:           types.StringUnknown(),
+		Summary:        types.StringUnknown(),
+		PointOfContact: types.StringNull(),
+		Team:           types.StringNull(),
+	}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	if !strings.Contains(created, `"team":{"id":"PTEAM01"`) {
+		t.Errorf("expected the business service to be created with the default team, got %s", created)
+	}
+	var model resourceBusinessServiceModel
+	if diags := resp.State.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !model.Team.IsNull() {
+		t.Errorf("expected the default team to be left out of the state, got %s", model.Team)
+	}
+}
+
 func testAccCheckPagerDutyBusinessServiceExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
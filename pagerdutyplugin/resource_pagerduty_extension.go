@@ -21,13 +21,20 @@ import (
 	"github.com/shonun1/terraform-provider-pagerduty/util"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
-type resourceExtension struct{ client *pagerduty.Client }
+type resourceExtension struct {
+	client        *pagerduty.Client
+	strictMissing bool
+}
 
 var (
-	_ resource.ResourceWithConfigure   = (*resourceExtension)(nil)
-	_ resource.ResourceWithImportState = (*resourceExtension)(nil)
+	_ resource.ResourceWithConfigure      = (*resourceExtension)(nil)
+	_ resource.ResourceWithImportState    = (*resourceExtension)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceExtension)(nil)
 )
 
 func (r *resourceExtension) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -85,10 +92,47 @@ func (r *resourceExtension) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:   true,
 				CustomType: jsontypes.NormalizedType{},
 			},
+			"event_types": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(extensionEventTypes...)),
+				},
+			},
 		},
 	}
 }
 
+// ValidateConfig rejects the `notify_types` of config set together with
+// event_types, as event_types are sent as the `notify_types` of config.
+func (r *resourceExtension) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model resourceExtensionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if model.EventTypes.IsNull() || model.Config.IsNull() || model.Config.IsUnknown() {
+		return
+	}
+
+	var config interface{}
+	if err := json.Unmarshal([]byte(model.Config.ValueString()), &config); err != nil {
+		return
+	}
+	if c, ok := config.(map[string]interface{}); ok {
+		if _, ok := c["notify_types"]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("event_types"),
+				"Conflicting extension event types",
+				"event_types can't be set together with the notify_types of config, as they are sent as the notify_types of config. Set the event types with only one of them.",
+			)
+		}
+	}
+}
+
 func (r *resourceExtension) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model resourceExtensionModel
 
@@ -110,7 +154,7 @@ func (r *resourceExtension) Create(ctx context.Context, req resource.CreateReque
 	plan.ID = extension.ID
 
 	accessToken := buildExtensionConfigAccessToken(model.Config, &resp.Diagnostics)
-	model = requestGetExtension(ctx, r.client, plan.ID, accessToken, &resp.Diagnostics)
+	model = requestGetExtension(ctx, r.client, plan.ID, accessToken, model.Config, model.EventTypes, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -136,12 +180,12 @@ func (r *resourceExtension) Read(ctx context.Context, req resource.ReadRequest,
 			return retry.RetryableError(err)
 		}
 		accessToken := buildExtensionConfigAccessToken(state.Config, &resp.Diagnostics)
-		state = flattenExtension(extension, accessToken, &resp.Diagnostics)
+		state = flattenExtension(extension, accessToken, state.Config, state.EventTypes, &resp.Diagnostics)
 		return nil
 	})
 	if err != nil {
 		if util.IsNotFoundError(err) {
-			resp.State.RemoveResource(ctx)
+			removeMissingResource(ctx, "pagerduty_extension", state.ID.ValueString(), r.strictMissing, resp)
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -183,7 +227,7 @@ func (r *resourceExtension) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	accessToken := buildExtensionConfigAccessToken(model.Config, &resp.Diagnostics)
-	model = requestGetExtension(ctx, r.client, plan.ID, accessToken, &resp.Diagnostics)
+	model = requestGetExtension(ctx, r.client, plan.ID, accessToken, model.Config, model.EventTypes, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -213,10 +257,11 @@ func (r *resourceExtension) Delete(ctx context.Context, req resource.DeleteReque
 
 func (r *resourceExtension) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	r.strictMissing = configuredProvider(req.ProviderData).strictMissing
 }
 
 func (r *resourceExtension) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	model := requestGetExtension(ctx, r.client, req.ID, nil, &resp.Diagnostics)
+	model := requestGetExtension(ctx, r.client, req.ID, nil, jsontypes.NewNormalizedNull(), types.ListNull(types.StringType), &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -229,13 +274,14 @@ type resourceExtensionModel struct {
 	EndpointURL      types.String         `tfsdk:"endpoint_url"`
 	ExtensionObjects types.Set            `tfsdk:"extension_objects"`
 	ExtensionSchema  types.String         `tfsdk:"extension_schema"`
+	EventTypes       types.List           `tfsdk:"event_types"`
 	HTMLURL          types.String         `tfsdk:"html_url"`
 	ID               types.String         `tfsdk:"id"`
 	Summary          types.String         `tfsdk:"summary"`
 	Type             types.String         `tfsdk:"type"`
 }
 
-func requestGetExtension(ctx context.Context, client *pagerduty.Client, id string, accessToken *string, diags *diag.Diagnostics) resourceExtensionModel {
+func requestGetExtension(ctx context.Context, client *pagerduty.Client, id string, accessToken *string, config jsontypes.Normalized, eventTypes types.List, diags *diag.Diagnostics) resourceExtensionModel {
 	var model resourceExtensionModel
 	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		extension, err := client.GetExtensionWithContext(ctx, id)
@@ -245,7 +291,7 @@ func requestGetExtension(ctx context.Context, client *pagerduty.Client, id strin
 			}
 			return retry.RetryableError(err)
 		}
-		model = flattenExtension(extension, accessToken, diags)
+		model = flattenExtension(extension, accessToken, config, eventTypes, diags)
 		return nil
 	})
 	if err != nil {
@@ -265,11 +311,41 @@ func buildPagerdutyExtension(ctx context.Context, model *resourceExtensionModel,
 		ExtensionObjects: buildExtensionObjects(ctx, model.ExtensionObjects, diags),
 		ExtensionSchema:  buildExtensionSchema(model.ExtensionSchema),
 	}
+	extension.Config = buildExtensionEventTypes(ctx, extension.Config, model.EventTypes, diags)
 	extension.ID = model.ID.ValueString()
 	extension.Type = "extension"
 	return &extension
 }
 
+// extensionEventTypes are the incident events an extension can be filtered
+// on, they are configured through the `notify_types` object of the extension
+// config.
+var extensionEventTypes = []string{"trigger", "acknowledge", "resolve"}
+
+func buildExtensionEventTypes(ctx context.Context, config interface{}, list types.List, diags *diag.Diagnostics) interface{} {
+	if list.IsNull() || list.IsUnknown() {
+		return config
+	}
+
+	var values []string
+	diags.Append(list.ElementsAs(ctx, &values, false)...)
+
+	c, ok := config.(map[string]interface{})
+	if !ok {
+		c = map[string]interface{}{}
+	}
+	notifyTypes, ok := c["notify_types"].(map[string]interface{})
+	if !ok {
+		notifyTypes = map[string]interface{}{}
+	}
+	for _, t := range extensionEventTypes {
+		notifyTypes[t] = util.Contains(values, t)
+	}
+	c["notify_types"] = notifyTypes
+
+	return c
+}
+
 func buildExtensionSchema(s types.String) pagerduty.APIObject {
 	if s.IsNull() || s.IsUnknown() {
 		return pagerduty.APIObject{}
@@ -336,7 +412,17 @@ func buildExtensionConfigAccessToken(s jsontypes.Normalized, diags *diag.Diagnos
 	return nil
 }
 
-func flattenExtension(response *pagerduty.Extension, accessToken *string, diags *diag.Diagnostics) resourceExtensionModel {
+func flattenExtension(response *pagerduty.Extension, accessToken *string, config jsontypes.Normalized, eventTypes types.List, diags *diag.Diagnostics) resourceExtensionModel {
+	// The event types are read from the config before its notify_types are
+	// left out of it.
+	setByEventTypes := isNotifyTypesSetByEventTypes(config, eventTypes)
+	eventTypes = flattenExtensionEventTypes(response.Config, eventTypes, diags)
+	if setByEventTypes {
+		if c, ok := response.Config.(map[string]interface{}); ok {
+			delete(c, "notify_types")
+		}
+	}
+
 	model := resourceExtensionModel{
 		ID:               types.StringValue(response.ID),
 		Name:             types.StringValue(response.Name),
@@ -347,10 +433,79 @@ func flattenExtension(response *pagerduty.Extension, accessToken *string, diags
 		Config:           flattenExtensionConfig(response.Config, accessToken, diags),
 		ExtensionSchema:  types.StringValue(response.ExtensionSchema.ID),
 		ExtensionObjects: flattenExtensionObjects(response.ExtensionObjects, diags),
+		EventTypes:       eventTypes,
 	}
 	return model
 }
 
+// isNotifyTypesSetByEventTypes reports whether the `notify_types` of config
+// are set from event_types, when config doesn't have them itself, in which
+// case they're left out of the config read back.
+func isNotifyTypesSetByEventTypes(config jsontypes.Normalized, eventTypes types.List) bool {
+	if eventTypes.IsNull() || eventTypes.IsUnknown() {
+		return false
+	}
+	if config.IsNull() || config.IsUnknown() {
+		return true
+	}
+
+	var c interface{}
+	if err := json.Unmarshal([]byte(config.ValueString()), &c); err != nil {
+		return false
+	}
+	m, ok := c.(map[string]interface{})
+	if !ok {
+		return true
+	}
+	_, ok = m["notify_types"]
+	return !ok
+}
+
+// flattenExtensionEventTypes reads the event types an extension is notified
+// of from its config. Event types not reported back by the API keep the value
+// they had before, and the order of the previous value is preserved.
+func flattenExtensionEventTypes(config interface{}, prev types.List, diags *diag.Diagnostics) types.List {
+	var prevValues []string
+	if !prev.IsNull() && !prev.IsUnknown() {
+		for _, v := range prev.Elements() {
+			if s, ok := v.(types.String); ok {
+				prevValues = append(prevValues, s.ValueString())
+			}
+		}
+	}
+
+	var notifyTypes map[string]interface{}
+	if c, ok := config.(map[string]interface{}); ok {
+		notifyTypes, _ = c["notify_types"].(map[string]interface{})
+	}
+
+	enabled := make(map[string]bool)
+	for _, t := range extensionEventTypes {
+		if v, ok := notifyTypes[t].(bool); ok {
+			enabled[t] = v
+			continue
+		}
+		enabled[t] = util.Contains(prevValues, t)
+	}
+
+	values := []attr.Value{}
+	for _, t := range prevValues {
+		if enabled[t] {
+			values = append(values, types.StringValue(t))
+			enabled[t] = false
+		}
+	}
+	for _, t := range extensionEventTypes {
+		if enabled[t] {
+			values = append(values, types.StringValue(t))
+		}
+	}
+
+	list, d := types.ListValue(types.StringType, values)
+	diags.Append(d...)
+	return list
+}
+
 func flattenExtensionConfig(config interface{}, accessToken *string, diags *diag.Diagnostics) jsontypes.Normalized {
 	if c, ok := config.(map[string]interface{}); ok {
 		if accessToken == nil {
@@ -23,7 +23,10 @@ import (
 	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
-type resourceExtensionServiceNow struct{ client *pagerduty.Client }
+type resourceExtensionServiceNow struct {
+	client        *pagerduty.Client
+	strictMissing bool
+}
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceExtensionServiceNow)(nil)
@@ -124,7 +127,7 @@ func (r *resourceExtensionServiceNow) Read(ctx context.Context, req resource.Rea
 	})
 	if err != nil {
 		if util.IsNotFoundError(err) {
-			resp.State.RemoveResource(ctx)
+			removeMissingResource(ctx, "pagerduty_extension_servicenow", id, r.strictMissing, resp)
 		}
 		return
 	}
@@ -196,6 +199,7 @@ func (r *resourceExtensionServiceNow) Delete(ctx context.Context, req resource.D
 
 func (r *resourceExtensionServiceNow) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	r.strictMissing = configuredProvider(req.ProviderData).strictMissing
 }
 
 func (r *resourceExtensionServiceNow) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -4,10 +4,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -87,6 +94,52 @@ func TestAccPagerDutyExtension_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyExtension_EventTypes(t *testing.T) {
+	extensionName := id.PrefixedUniqueId("tf-")
+	name := id.PrefixedUniqueId("tf-")
+	url := "https://example.com/receive_a_pagerduty_webhook"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutyExtensionConfig_EventTypes(name, extensionName, url, `["assign"]`, "null"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
+			{
+				Config:      testAccCheckPagerDutyExtensionConfig_EventTypes(name, extensionName, url, `["acknowledge"]`, `jsonencode({notify_types = {acknowledge = true}})`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("event_types can't be set together with the notify_types of config"),
+			},
+			{
+				Config: testAccCheckPagerDutyExtensionConfig_EventTypes(name, extensionName, url, `["acknowledge"]`, "null"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyExtensionExists("pagerduty_extension.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension.foo", "event_types.#", "1"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension.foo", "event_types.0", "acknowledge"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyExtensionConfig_EventTypes(name, extensionName, url, `["resolve", "acknowledge"]`, `jsonencode({restrict = "any"})`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyExtensionExists("pagerduty_extension.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension.foo", "event_types.#", "2"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension.foo", "event_types.0", "resolve"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_extension.foo", "event_types.1", "acknowledge"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyExtensionDestroy(s *terraform.State) error {
 	for _, r := range s.RootModule().Resources {
 		if r.Type != "pagerduty_extension" {
@@ -251,3 +304,104 @@ EOF
 }
 `, name, extension_name, restrict, notify_types)
 }
+
+func testAccCheckPagerDutyExtensionConfig_EventTypes(name, extensionName, url, eventTypes, config string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%[1]v"
+  email       = "%[1]v@foo.test"
+  color       = "green"
+  role        = "user"
+  job_title   = "foo"
+  description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%[1]v"
+  description = "bar"
+  num_loops   = 2
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name                    = "%[1]v"
+  description             = "foo"
+  auto_resolve_timeout    = 1800
+  acknowledgement_timeout = 1800
+  escalation_policy       = pagerduty_escalation_policy.foo.id
+
+  incident_urgency_rule {
+    type    = "constant"
+    urgency = "high"
+  }
+}
+
+data "pagerduty_extension_schema" "foo" {
+	name = "Generic V2 Webhook"
+}
+
+resource "pagerduty_extension" "foo"{
+  name = "%[2]v"
+  endpoint_url = "%[3]v"
+  extension_schema = data.pagerduty_extension_schema.foo.id
+  extension_objects = [pagerduty_service.foo.id]
+  event_types = %[4]v
+  config = %[5]v
+}
+`, name, extensionName, url, eventTypes, config)
+}
+
+func TestResourcePagerDutyExtension_EventTypesConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &resourceExtension{}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	eventTypes := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("acknowledge")})
+	validate := func(config jsontypes.Normalized) *fwresource.ValidateConfigResponse {
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := state.Set(ctx, &resourceExtensionModel{
+			Config:           config,
+			EventTypes:       eventTypes,
+			ExtensionObjects: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("PSERV01")}),
+			ExtensionSchema:  types.StringValue("PSCHEMA"),
+		}); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		resp := &fwresource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}, resp)
+		return resp
+	}
+
+	if resp := validate(jsontypes.NewNormalizedValue(`{"notify_types":{"acknowledge":true}}`)); !resp.Diagnostics.HasError() {
+		t.Error("expected event_types to be rejected together with the notify_types of config")
+	}
+	if resp := validate(jsontypes.NewNormalizedValue(`{"restrict":"any"}`)); resp.Diagnostics.HasError() {
+		t.Errorf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	// The notify_types sent for event_types aren't read back in config.
+	var diags diag.Diagnostics
+	response := &pagerduty.Extension{Config: map[string]interface{}{
+		"restrict":     "any",
+		"notify_types": map[string]interface{}{"trigger": false, "acknowledge": true, "resolve": false},
+	}}
+	model := flattenExtension(response, nil, jsontypes.NewNormalizedValue(`{"restrict":"any"}`), eventTypes, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got := model.Config.ValueString(); got != `{"restrict":"any"}` {
+		t.Errorf("expected the notify_types to be left out of config, got %s", got)
+	}
+	if !model.EventTypes.Equal(eventTypes) {
+		t.Errorf("expected the event types to be read from the notify_types, got %v", model.EventTypes)
+	}
+}
@@ -26,7 +26,8 @@ import (
 )
 
 type resourceServiceDependency struct {
-	client *pagerduty.Client
+	client        *pagerduty.Client
+	strictMissing bool
 }
 
 var (
@@ -190,13 +191,14 @@ func (r *resourceServiceDependency) Read(ctx context.Context, req resource.ReadR
 
 	log.Printf("Reading PagerDuty dependency %s", serviceDependency.ID)
 
+	id := serviceDependency.ID
 	serviceDependency, err := r.requestGetServiceDependency(ctx, serviceDependency.ID, serviceDependency.DependentService.ID, serviceDependency.DependentService.Type)
-	if serviceDependency == nil || util.IsNotFoundError(err) {
-		resp.State.RemoveResource(ctx)
+	if err != nil && !util.IsNotFoundError(err) {
+		resp.Diagnostics.AddError("Error listing service dependencies", err.Error())
 		return
 	}
-	if err != nil {
-		resp.Diagnostics.AddError("Error listing service dependencies", err.Error())
+	if serviceDependency == nil {
+		removeMissingResource(ctx, "pagerduty_service_dependency", id, r.strictMissing, resp)
 		return
 	}
 
@@ -325,6 +327,7 @@ func (r *resourceServiceDependency) requestGetServiceDependency(ctx context.Cont
 
 func (r *resourceServiceDependency) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	r.strictMissing = configuredProvider(req.ProviderData).strictMissing
 }
 
 func (r *resourceServiceDependency) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
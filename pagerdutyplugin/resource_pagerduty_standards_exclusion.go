@@ -0,0 +1,321 @@
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/shonun1/terraform-provider-pagerduty/util"
+)
+
+const standardsExclusionType = "technical_service_reference"
+
+type resourceStandardsExclusion struct {
+	client        *pagerduty.Client
+	apiURL        string
+	strictMissing bool
+}
+
+var (
+	_ resource.ResourceWithConfigure   = (*resourceStandardsExclusion)(nil)
+	_ resource.ResourceWithImportState = (*resourceStandardsExclusion)(nil)
+)
+
+func (r *resourceStandardsExclusion) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_standards_exclusion"
+}
+
+func (r *resourceStandardsExclusion) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"standard_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"service_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+func (r *resourceStandardsExclusion) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceStandardsExclusionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	standardID, serviceID := model.StandardID.ValueString(), model.ServiceID.ValueString()
+	log.Printf("[INFO] Excluding PagerDuty service %s from standard %s", serviceID, standardID)
+
+	resourceStandardsExclusionMu.Lock()
+	defer resourceStandardsExclusionMu.Unlock()
+
+	standard := r.requestGetStandard(ctx, standardID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if standard == nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error excluding PagerDuty service %s from standard %s", serviceID, standardID),
+			fmt.Sprintf("No standard with the ID %s was found", standardID),
+		)
+		return
+	}
+
+	if !isServiceExcludedFromStandard(standard, serviceID) {
+		standard.Exclusions = append(standard.Exclusions, pagerduty.StandardInclusionExclusion{
+			Type: standardsExclusionType,
+			ID:   serviceID,
+		})
+		r.requestUpdateStandard(ctx, standard, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	model.ID = flattenStandardsExclusionID(standardID, serviceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceStandardsExclusion) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state resourceStandardsExclusionModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	log.Printf("[INFO] Reading PagerDuty standards exclusion %s", state.ID)
+
+	standard := r.requestGetStandard(ctx, state.StandardID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if standard == nil || !isServiceExcludedFromStandard(standard, state.ServiceID.ValueString()) {
+		removeMissingResource(ctx, "pagerduty_standards_exclusion", state.ID.ValueString(), r.strictMissing, resp)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *resourceStandardsExclusion) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *resourceStandardsExclusion) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model resourceStandardsExclusionModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	standardID, serviceID := model.StandardID.ValueString(), model.ServiceID.ValueString()
+	log.Printf("[INFO] Removing the exclusion of PagerDuty service %s from standard %s", serviceID, standardID)
+
+	resourceStandardsExclusionMu.Lock()
+	defer resourceStandardsExclusionMu.Unlock()
+
+	standard := r.requestGetStandard(ctx, standardID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if standard == nil || !isServiceExcludedFromStandard(standard, serviceID) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	exclusions := make([]pagerduty.StandardInclusionExclusion, 0, len(standard.Exclusions))
+	for _, exc := range standard.Exclusions {
+		if exc.ID != serviceID {
+			exclusions = append(exclusions, exc)
+		}
+	}
+
+	standard.Exclusions = exclusions
+	if len(exclusions) == 0 {
+		r.requestClearStandardExclusions(ctx, standard, &resp.Diagnostics)
+	} else {
+		r.requestUpdateStandard(ctx, standard, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceStandardsExclusion) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	r.strictMissing = configuredProvider(req.ProviderData).strictMissing
+	r.apiURL = configuredProvider(req.ProviderData).apiURL
+}
+
+func (r *resourceStandardsExclusion) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ".")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Error importing pagerduty_standards_exclusion",
+			"Expecting an importation ID formed as '<standard_id>.<service_id>'",
+		)
+		return
+	}
+	standardID, serviceID := ids[0], ids[1]
+
+	standard := r.requestGetStandard(ctx, standardID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if standard == nil || !isServiceExcludedFromStandard(standard, serviceID) {
+		resp.Diagnostics.AddError(
+			"Error importing pagerduty_standards_exclusion",
+			fmt.Sprintf("Service %s is not excluded from standard %s", serviceID, standardID),
+		)
+		return
+	}
+
+	model := resourceStandardsExclusionModel{
+		ID:         flattenStandardsExclusionID(standardID, serviceID),
+		StandardID: types.StringValue(standardID),
+		ServiceID:  types.StringValue(serviceID),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// requestGetStandard looks for the standard in the list of standards, the API
+// has no endpoint to get a single standard. Returns nil when it's not found.
+func (r *resourceStandardsExclusion) requestGetStandard(ctx context.Context, id string, diags *diag.Diagnostics) *pagerduty.Standard {
+	var found *pagerduty.Standard
+
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		list, err := r.client.ListStandards(ctx, pagerduty.ListStandardsOptions{})
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+
+		found = nil
+		for i := range list.Standards {
+			if list.Standards[i].ID == id {
+				found = &list.Standards[i]
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error reading PagerDuty standard %s", id),
+			err.Error(),
+		)
+	}
+
+	return found
+}
+
+func (r *resourceStandardsExclusion) requestUpdateStandard(ctx context.Context, standard *pagerduty.Standard, diags *diag.Diagnostics) {
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		if _, err := r.client.UpdateStandard(ctx, standard.ID, *standard); err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error updating the exclusions of PagerDuty standard %s", standard.ID),
+			err.Error(),
+		)
+	}
+}
+
+// requestClearStandardExclusions removes the last exclusion of the standard.
+// The client omits empty lists of exclusions from its update requests, which
+// leaves them as they are, so the request is sent without its help.
+func (r *resourceStandardsExclusion) requestClearStandardExclusions(ctx context.Context, standard *pagerduty.Standard, diags *diag.Diagnostics) {
+	body, err := json.Marshal(standardWithExclusions{
+		Standard:   *standard,
+		Exclusions: []pagerduty.StandardInclusionExclusion{},
+	})
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error updating the exclusions of PagerDuty standard %s", standard.ID), err.Error())
+		return
+	}
+
+	err = retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, strings.TrimSuffix(r.apiURL, "/")+"/standards/"+standard.ID, bytes.NewReader(body))
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+		resp, err := r.client.Do(req, true)
+		if err != nil {
+			return retry.RetryableError(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= http.StatusBadRequest {
+			apiErr := pagerduty.APIError{StatusCode: resp.StatusCode}
+			json.NewDecoder(resp.Body).Decode(&apiErr)
+			if util.IsBadRequestError(apiErr) {
+				return retry.NonRetryableError(apiErr)
+			}
+			return retry.RetryableError(apiErr)
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error updating the exclusions of PagerDuty standard %s", standard.ID),
+			err.Error(),
+		)
+	}
+}
+
+// standardWithExclusions always sends the exclusions of a standard, even when
+// there's none.
+type standardWithExclusions struct {
+	pagerduty.Standard
+	Exclusions []pagerduty.StandardInclusionExclusion `json:"exclusions"`
+}
+
+func isServiceExcludedFromStandard(standard *pagerduty.Standard, serviceID string) bool {
+	for _, exc := range standard.Exclusions {
+		if exc.ID == serviceID {
+			return true
+		}
+	}
+	return false
+}
+
+func flattenStandardsExclusionID(standardID, serviceID string) types.String {
+	return types.StringValue(fmt.Sprintf("%v.%v", standardID, serviceID))
+}
+
+var resourceStandardsExclusionMu sync.Mutex
+
+type resourceStandardsExclusionModel struct {
+	ID         types.String `tfsdk:"id"`
+	StandardID types.String `tfsdk:"standard_id"`
+	ServiceID  types.String `tfsdk:"service_id"`
+}
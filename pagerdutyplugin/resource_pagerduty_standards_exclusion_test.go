@@ -0,0 +1,174 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/PagerDuty/go-pagerduty"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPagerDutyStandardsExclusion_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyStandardsExclusionConfig(name, email, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyStandardsExclusion("pagerduty_standards_exclusion.foo", "pagerduty_service.foo", true),
+					testAccCheckPagerDutyStandardsExclusion("pagerduty_standards_exclusion.foo", "pagerduty_service.bar", true),
+				),
+			},
+			{
+				ResourceName:      "pagerduty_standards_exclusion.bar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCheckPagerDutyStandardsExclusionConfig(name, email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyStandardsExclusion("pagerduty_standards_exclusion.foo", "pagerduty_service.foo", true),
+					testAccCheckPagerDutyStandardsExclusion("pagerduty_standards_exclusion.foo", "pagerduty_service.bar", false),
+				),
+			},
+		},
+	})
+}
+
+func TestResourcePagerDutyStandardsExclusionDelete_LastExclusion(t *testing.T) {
+	var updated string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/standards":
+			w.Write([]byte(`{"standards":[{"id":"PSTD001","name":"Has a description","active":true,"resource_type":"technical_service","exclusions":[{"type":"technical_service_reference","id":"PSVC001"}]}]}`))
+		case r.Method == http.MethodPut && r.URL.Path == "/standards/PSTD001":
+			body, _ := io.ReadAll(r.Body)
+			updated = string(body)
+			w.Write(body)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &resourceStandardsExclusion{
+		client: pagerduty.NewClient("foo", pagerduty.WithAPIEndpoint(server.URL)),
+		apiURL: server.URL,
+	}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &resourceStandardsExclusionModel{
+		ID:         types.StringValue("PSTD001.PSVC001"),
+		StandardID: types.StringValue("PSTD001"),
+		ServiceID:  types.StringValue("PSVC001"),
+	}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	resp := &fwresource.DeleteResponse{State: state}
+	r.Delete(ctx, fwresource.DeleteRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() > 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if !strings.Contains(updated, `"exclusions":[]`) {
+		t.Errorf("expected the last exclusion to be removed with an empty list of exclusions, got %s", updated)
+	}
+}
+
+// testAccCheckPagerDutyStandardsExclusion checks the service is in the
+// exclusions of the standard of the exclusion resource n or not.
+func testAccCheckPagerDutyStandardsExclusion(n, service string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		standardID := strings.Split(rs.Primary.ID, ".")[0]
+		serviceID := s.RootModule().Resources[service].Primary.ID
+
+		list, err := testAccProvider.client.ListStandards(context.Background(), pagerduty.ListStandardsOptions{})
+		if err != nil {
+			return err
+		}
+		for i := range list.Standards {
+			if list.Standards[i].ID != standardID {
+				continue
+			}
+			if got := isServiceExcludedFromStandard(&list.Standards[i], serviceID); got != expected {
+				return fmt.Errorf("Expected service %s to be excluded from standard %s: %v, got: %v", serviceID, standardID, expected, got)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("Standard %s not found", standardID)
+	}
+}
+
+func testAccCheckPagerDutyStandardsExclusionConfig(name, email string, excludeBar bool) string {
+	bar := ""
+	if excludeBar {
+		bar = `
+resource "pagerduty_standards_exclusion" "bar" {
+	standard_id = data.pagerduty_standards.foo.standards[0].id
+	service_id  = pagerduty_service.bar.id
+}
+`
+	}
+
+	return fmt.Sprintf(`
+data "pagerduty_standards" "foo" {
+	resource_type = "technical_service"
+}
+
+resource "pagerduty_user" "foo" {
+	name  = "%[1]s"
+	email = "%[2]s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name      = "%[1]s"
+	num_loops = 1
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name              = "%[1]s-foo"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service" "bar" {
+	name              = "%[1]s-bar"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_standards_exclusion" "foo" {
+	standard_id = data.pagerduty_standards.foo.standards[0].id
+	service_id  = pagerduty_service.foo.id
+}
+%[3]s`, name, email, bar)
+}
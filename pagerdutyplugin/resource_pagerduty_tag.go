@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,7 +19,8 @@ import (
 )
 
 type resourceTag struct {
-	client *pagerduty.Client
+	client        *pagerduty.Client
+	strictMissing bool
 }
 
 var (
@@ -28,6 +30,7 @@ var (
 
 func (r *resourceTag) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	r.strictMissing = configuredProvider(req.ProviderData).strictMissing
 }
 
 func (r *resourceTag) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,9 +46,10 @@ func (r *resourceTag) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"html_url": schema.StringAttribute{Computed: true},
-			"id":       schema.StringAttribute{Computed: true},
-			"summary":  schema.StringAttribute{Computed: true},
+			"adopt_existing": schema.BoolAttribute{Optional: true},
+			"html_url":       schema.StringAttribute{Computed: true},
+			"id":             schema.StringAttribute{Computed: true},
+			"summary":        schema.StringAttribute{Computed: true},
 		},
 	}
 }
@@ -56,6 +60,22 @@ func (r *resourceTag) Create(ctx context.Context, req resource.CreateRequest, re
 		resp.Diagnostics.Append(d...)
 	}
 	tagBody := buildTag(&model)
+	adoptExisting := model.AdoptExisting
+
+	if adoptExisting.ValueBool() {
+		existing := r.requestFindTagByLabel(ctx, tagBody.Label, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if existing != nil {
+			log.Printf("[INFO] Adopting existing PagerDuty tag %s (%s)", existing.Label, existing.ID)
+			model = flattenTag(existing)
+			model.AdoptExisting = adoptExisting
+			resp.State.Set(ctx, &model)
+			return
+		}
+	}
+
 	log.Printf("[INFO] Creating PagerDuty tag %s", tagBody.Label)
 
 	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
@@ -73,6 +93,7 @@ func (r *resourceTag) Create(ctx context.Context, req resource.CreateRequest, re
 	if err != nil {
 		resp.Diagnostics.AddError("Error calling CreateTagWithContext", err.Error())
 	}
+	model.AdoptExisting = adoptExisting
 	resp.State.Set(ctx, &model)
 }
 
@@ -81,32 +102,51 @@ func (r *resourceTag) Read(ctx context.Context, req resource.ReadRequest, resp *
 	if d := req.State.GetAttribute(ctx, path.Root("id"), &tagID); d.HasError() {
 		resp.Diagnostics.Append(d...)
 	}
+	var adoptExisting types.Bool
+	if d := req.State.GetAttribute(ctx, path.Root("adopt_existing"), &adoptExisting); d.HasError() {
+		resp.Diagnostics.Append(d...)
+	}
 	log.Printf("[INFO] Reading PagerDuty tag %s", tagID)
 
 	var model resourceTagModel
 	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
 		tag, err := r.client.GetTagWithContext(ctx, tagID.ValueString())
 		if err != nil {
-			if util.IsBadRequestError(err) {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
 				return retry.NonRetryableError(err)
 			}
-			if util.IsNotFoundError(err) {
-				log.Printf("[WARN] Removing %s because it's gone", tagID.String())
-				resp.State.RemoveResource(ctx)
-				return nil
-			}
 			return retry.RetryableError(err)
 		}
 		model = flattenTag(tag)
+		model.AdoptExisting = adoptExisting
 		return nil
 	})
+	if util.IsNotFoundError(err) {
+		log.Printf("[WARN] Removing %s because it's gone", tagID.String())
+		removeMissingResource(ctx, "pagerduty_tag", tagID.ValueString(), r.strictMissing, resp)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error calling GetTagWithContext", err.Error())
 	}
 	resp.State.Set(ctx, &model)
 }
 
-func (r *resourceTag) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+// Update only happens when adopt_existing changes, as the label requires
+// replacing the tag, so the tag is kept as in the state.
+func (r *resourceTag) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model resourceTagModel
+	if d := req.State.Get(ctx, &model); d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+	var adoptExisting types.Bool
+	if d := req.Plan.GetAttribute(ctx, path.Root("adopt_existing"), &adoptExisting); d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+	model.AdoptExisting = adoptExisting
+	resp.State.Set(ctx, &model)
 }
 
 func (r *resourceTag) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -141,11 +181,42 @@ func (r *resourceTag) ImportState(ctx context.Context, req resource.ImportStateR
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// requestFindTagByLabel looks for the tag with the label, returns nil when
+// there isn't any.
+func (r *resourceTag) requestFindTagByLabel(ctx context.Context, label string, diags *diag.Diagnostics) *pagerduty.Tag {
+	var found *pagerduty.Tag
+
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		list, err := r.client.ListTagsPaginated(ctx, pagerduty.ListTagOptions{Query: label, Limit: 100})
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+
+		found = nil
+		for _, tag := range list {
+			if tag.Label == label {
+				found = tag
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddError("Error reading list of tags", err.Error())
+	}
+
+	return found
+}
+
 type resourceTagModel struct {
-	ID      types.String `tfsdk:"id"`
-	HTMLURL types.String `tfsdk:"html_url"`
-	Label   types.String `tfsdk:"label"`
-	Summary types.String `tfsdk:"summary"`
+	ID            types.String `tfsdk:"id"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+	HTMLURL       types.String `tfsdk:"html_url"`
+	Label         types.String `tfsdk:"label"`
+	Summary       types.String `tfsdk:"summary"`
 }
 
 func buildTag(model *resourceTagModel) *pagerduty.Tag {
@@ -20,7 +20,10 @@ import (
 	"github.com/shonun1/terraform-provider-pagerduty/util"
 )
 
-type resourceTagAssignment struct{ client *pagerduty.Client }
+type resourceTagAssignment struct {
+	client        *pagerduty.Client
+	strictMissing bool
+}
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceTagAssignment)(nil)
@@ -110,8 +113,11 @@ func (r *resourceTagAssignment) Read(ctx context.Context, req resource.ReadReque
 	log.Printf("[INFO] Reading PagerDuty tag assignment %s", state.ID)
 
 	isFound := r.requestGetTagAssignents(ctx, state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if !isFound {
-		resp.State.RemoveResource(ctx)
+		removeMissingResource(ctx, "pagerduty_tag_assignment", state.ID.ValueString(), r.strictMissing, resp)
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -242,6 +248,7 @@ func (r *resourceTagAssignment) Delete(ctx context.Context, req resource.DeleteR
 
 func (r *resourceTagAssignment) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	r.strictMissing = configuredProvider(req.ProviderData).strictMissing
 }
 
 func (r *resourceTagAssignment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
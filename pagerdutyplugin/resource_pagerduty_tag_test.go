@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -70,6 +71,47 @@ func TestAccPagerDutyTag_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyTag_AdoptExisting(t *testing.T) {
+	tagLabel := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTagConfig(tagLabel),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTagExists("pagerduty_tag.foo"),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyTagAdoptExistingConfig(tagLabel, false),
+				ExpectError: regexp.MustCompile("Error calling CreateTagWithContext"),
+			},
+			{
+				Config: testAccCheckPagerDutyTagAdoptExistingConfig(tagLabel, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTagExists("pagerduty_tag.bar"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_tag.bar", "id", "pagerduty_tag.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_tag.bar", "adopt_existing", "true"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTagAdoptExistingConfig(tagLabel, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_tag.bar", "id", "pagerduty_tag.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_tag.bar", "adopt_existing", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyTagDestroy(s *terraform.State) error {
 	client := testAccProvider.client
 	ctx := context.Background()
@@ -137,3 +179,17 @@ resource "pagerduty_tag" "foo" {
 }
 `, tagLabel)
 }
+
+func testAccCheckPagerDutyTagAdoptExistingConfig(tagLabel string, adoptExisting bool) string {
+	return fmt.Sprintf(`
+resource "pagerduty_tag" "foo" {
+	label = "%[1]s"
+}
+
+resource "pagerduty_tag" "bar" {
+	label          = "%[1]s"
+	adopt_existing = %[2]t
+	depends_on     = [pagerduty_tag.foo]
+}
+`, tagLabel, adoptExisting)
+}
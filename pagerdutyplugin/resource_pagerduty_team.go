@@ -2,21 +2,31 @@ package pagerduty
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/shonun1/terraform-provider-pagerduty/util"
+	"github.com/shonun1/terraform-provider-pagerduty/util/apiutil"
 )
 
-type resourceTeam struct{ client *pagerduty.Client }
+type resourceTeam struct {
+	client        *pagerduty.Client
+	strictMissing bool
+}
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceTeam)(nil)
@@ -38,10 +48,23 @@ func (r *resourceTeam) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Default:  stringdefault.StaticString("Managed by Terraform"),
 			},
 			"html_url": schema.StringAttribute{Computed: true},
+			"self":     schema.StringAttribute{Computed: true},
 			"parent":   schema.StringAttribute{Optional: true},
 			"default_role": schema.StringAttribute{
-				Computed: true,
-				Optional: true,
+				Computed:   true,
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.OneOf("manager", "none")},
+			},
+			"tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"force_destroy": schema.BoolAttribute{Optional: true},
+			"read_members":  schema.BoolAttribute{Optional: true},
+			"members": schema.ListAttribute{
+				Computed:    true,
+				Description: "The users of the team with their role, read when read_members is true",
+				ElementType: teamMemberObjectType,
 			},
 		},
 	}
@@ -55,6 +78,9 @@ func (r *resourceTeam) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 	plan := buildPagerdutyTeam(&model)
+	planTags := model.Tags
+	forceDestroy := model.ForceDestroy
+	readMembers := model.ReadMembers
 	log.Printf("[INFO] Creating PagerDuty team %s", plan.Name)
 
 	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
@@ -76,8 +102,22 @@ func (r *resourceTeam) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	if err = requestUpdateTeamTags(ctx, r.client, plan.ID, types.SetNull(types.StringType), planTags); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error assigning tags to PagerDuty team %s", plan.Name),
+			err.Error(),
+		)
+		return
+	}
+
 	retryNotFound := true
 	model, err = requestGetTeam(ctx, r.client, plan, retryNotFound)
+	if err == nil {
+		model.Tags, err = requestGetTeamTags(ctx, r.client, plan.ID, planTags)
+	}
+	if err == nil {
+		model.Members, err = requestGetTeamMembers(ctx, r.client, plan.ID, readMembers)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error reading PagerDuty team %s", plan.Name),
@@ -85,6 +125,8 @@ func (r *resourceTeam) Create(ctx context.Context, req resource.CreateRequest, r
 		)
 		return
 	}
+	model.ForceDestroy = forceDestroy
+	model.ReadMembers = readMembers
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -98,12 +140,21 @@ func (r *resourceTeam) Read(ctx context.Context, req resource.ReadRequest, resp
 	log.Printf("[INFO] Reading PagerDuty team %s", state.ID)
 
 	plan := buildPagerdutyTeam(&state)
+	stateTags := state.Tags
+	forceDestroy := state.ForceDestroy
+	readMembers := state.ReadMembers
 
 	retryNotFound := false
 	state, err := requestGetTeam(ctx, r.client, plan, retryNotFound)
+	if err == nil {
+		state.Tags, err = requestGetTeamTags(ctx, r.client, plan.ID, stateTags)
+	}
+	if err == nil {
+		state.Members, err = requestGetTeamMembers(ctx, r.client, plan.ID, readMembers)
+	}
 	if err != nil {
 		if util.IsNotFoundError(err) {
-			resp.State.RemoveResource(ctx)
+			removeMissingResource(ctx, "pagerduty_team", plan.ID, r.strictMissing, resp)
 			return
 		}
 		resp.Diagnostics.AddError(
@@ -112,6 +163,8 @@ func (r *resourceTeam) Read(ctx context.Context, req resource.ReadRequest, resp
 		)
 		return
 	}
+	state.ForceDestroy = forceDestroy
+	state.ReadMembers = readMembers
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -124,6 +177,16 @@ func (r *resourceTeam) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	plan := buildPagerdutyTeam(&model)
+	planTags := model.Tags
+	forceDestroy := model.ForceDestroy
+	readMembers := model.ReadMembers
+
+	var stateTags types.Set
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tags"), &stateTags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if plan.ID == "" {
 		var id string
 		req.State.GetAttribute(ctx, path.Root("id"), &id)
@@ -142,6 +205,9 @@ func (r *resourceTeam) Update(ctx context.Context, req resource.UpdateRequest, r
 		model = flattenTeam(team, plan)
 		return nil
 	})
+	if err == nil {
+		err = requestUpdateTeamTags(ctx, r.client, plan.ID, stateTags, planTags)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error updating PagerDuty team %s", plan.ID),
@@ -152,6 +218,12 @@ func (r *resourceTeam) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	retryNotFound := false
 	model, err = requestGetTeam(ctx, r.client, plan, retryNotFound)
+	if err == nil {
+		model.Tags, err = requestGetTeamTags(ctx, r.client, plan.ID, planTags)
+	}
+	if err == nil {
+		model.Members, err = requestGetTeamMembers(ctx, r.client, plan.ID, readMembers)
+	}
 	if err != nil {
 		if util.IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
@@ -163,20 +235,45 @@ func (r *resourceTeam) Update(ctx context.Context, req resource.UpdateRequest, r
 		)
 		return
 	}
+	model.ForceDestroy = forceDestroy
+	model.ReadMembers = readMembers
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
 func (r *resourceTeam) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var id types.String
+	var forceDestroy types.Bool
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("force_destroy"), &forceDestroy)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
 	log.Printf("[INFO] Deleting PagerDuty team %s", id)
 
-	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
-		err := r.client.DeleteTeamWithContext(ctx, id.ValueString())
+	// The objects attached to the team may be on their way out, e.g. when
+	// they're destroyed along with it, so they're waited for until the
+	// timeout before giving up.
+	err := retry.RetryContext(ctx, teamDeleteTimeout, func() *retry.RetryError {
+		escalationPolicies, services, err := requestGetTeamDependents(ctx, r.client, id.ValueString())
+		if err != nil {
+			err = fmt.Errorf("error reading the objects attached to the team: %w", err)
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		if len(escalationPolicies) > 0 || len(services) > 0 {
+			if !forceDestroy.ValueBool() {
+				return retry.RetryableError(&teamDependentsError{escalationPolicies: escalationPolicies, services: services})
+			}
+			if err := requestDetachTeamEscalationPolicies(ctx, r.client, id.ValueString(), escalationPolicies); err != nil {
+				return retry.NonRetryableError(fmt.Errorf("error detaching the escalation policies of the team: %w", err))
+			}
+		}
+
+		err = r.client.DeleteTeamWithContext(ctx, id.ValueString())
 		if err != nil {
 			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
 				return retry.NonRetryableError(err)
@@ -185,6 +282,16 @@ func (r *resourceTeam) Delete(ctx context.Context, req resource.DeleteRequest, r
 		}
 		return nil
 	})
+	var dependentsErr *teamDependentsError
+	if errors.As(err, &dependentsErr) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("PagerDuty team %s can't be deleted while objects are attached to it", id),
+			dependentsErr.Error()+
+				" Remove them from the team, or set force_destroy to true to have the escalation policies, "+
+				"and the services using them, detached from the team before deleting it.",
+		)
+		return
+	}
 	if err != nil && !util.IsNotFoundError(err) {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error deleting PagerDuty team %s", id),
@@ -198,6 +305,7 @@ func (r *resourceTeam) Delete(ctx context.Context, req resource.DeleteRequest, r
 
 func (r *resourceTeam) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	r.strictMissing = configuredProvider(req.ProviderData).strictMissing
 }
 
 func (r *resourceTeam) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -205,12 +313,24 @@ func (r *resourceTeam) ImportState(ctx context.Context, req resource.ImportState
 }
 
 type resourceTeamModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	DefaultRole types.String `tfsdk:"default_role"`
-	Description types.String `tfsdk:"description"`
-	HTMLURL     types.String `tfsdk:"html_url"`
-	Parent      types.String `tfsdk:"parent"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	DefaultRole  types.String `tfsdk:"default_role"`
+	Description  types.String `tfsdk:"description"`
+	HTMLURL      types.String `tfsdk:"html_url"`
+	Self         types.String `tfsdk:"self"`
+	Parent       types.String `tfsdk:"parent"`
+	Tags         types.Set    `tfsdk:"tags"`
+	ForceDestroy types.Bool   `tfsdk:"force_destroy"`
+	ReadMembers  types.Bool   `tfsdk:"read_members"`
+	Members      types.List   `tfsdk:"members"`
+}
+
+var teamMemberObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"user_id": types.StringType,
+		"role":    types.StringType,
+	},
 }
 
 func requestGetTeam(ctx context.Context, client *pagerduty.Client, plan *pagerduty.Team, retryNotFound bool) (resourceTeamModel, error) {
@@ -258,7 +378,10 @@ func flattenTeam(response *pagerduty.Team, plan *pagerduty.Team) resourceTeamMod
 		Name:        types.StringValue(response.Name),
 		Description: types.StringValue(response.Description),
 		HTMLURL:     types.StringValue(response.HTMLURL),
+		Self:        types.StringValue(response.Self),
 		DefaultRole: types.StringValue(response.DefaultRole),
+		Tags:        types.SetNull(types.StringType),
+		Members:     types.ListNull(teamMemberObjectType),
 	}
 	if plan.Parent != nil {
 		model.Parent = types.StringValue(plan.Parent.ID)
@@ -268,3 +391,216 @@ func flattenTeam(response *pagerduty.Team, plan *pagerduty.Team) resourceTeamMod
 	}
 	return model
 }
+
+// requestUpdateTeamTags assigns and unassigns the tags of a team so they go
+// from the ones in state to the ones planned, using a single request.
+func requestUpdateTeamTags(ctx context.Context, client *pagerduty.Client, teamID string, state, plan types.Set) error {
+	var current, target []string
+	if !state.IsNull() && !state.IsUnknown() {
+		if diags := state.ElementsAs(ctx, &current, false); diags.HasError() {
+			return fmt.Errorf("%v", diags)
+		}
+	}
+	if !plan.IsNull() && !plan.IsUnknown() {
+		if diags := plan.ElementsAs(ctx, &target, false); diags.HasError() {
+			return fmt.Errorf("%v", diags)
+		}
+	}
+
+	currentSet := make(map[string]bool, len(current))
+	for _, id := range current {
+		currentSet[id] = true
+	}
+	targetSet := make(map[string]bool, len(target))
+	for _, id := range target {
+		targetSet[id] = true
+	}
+
+	assignments := &pagerduty.TagAssignments{}
+	for _, id := range target {
+		if !currentSet[id] {
+			assignments.Add = append(assignments.Add, &pagerduty.TagAssignment{Type: "tag_reference", TagID: id})
+		}
+	}
+	for _, id := range current {
+		if !targetSet[id] {
+			assignments.Remove = append(assignments.Remove, &pagerduty.TagAssignment{Type: "tag_reference", TagID: id})
+		}
+	}
+	if len(assignments.Add) == 0 && len(assignments.Remove) == 0 {
+		return nil
+	}
+	log.Printf("[INFO] Changing tags of PagerDuty team %s: adding %d, removing %d", teamID, len(assignments.Add), len(assignments.Remove))
+
+	return retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		if err := client.AssignTagsWithContext(ctx, "teams", teamID, assignments); err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+}
+
+// requestGetTeamTags returns the tags assigned to a team. Tags are only read
+// when prior is in use, so tags managed with pagerduty_tag_assignment don't
+// show up as a diff.
+func requestGetTeamTags(ctx context.Context, client *pagerduty.Client, teamID string, prior types.Set) (types.Set, error) {
+	if prior.IsNull() {
+		return types.SetNull(types.StringType), nil
+	}
+
+	var tags []*pagerduty.Tag
+	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		var err error
+		tags, err = client.GetTagsForEntityPaginated(ctx, "teams", teamID, pagerduty.ListTagOptions{})
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return prior, err
+	}
+
+	elements := make([]attr.Value, 0, len(tags))
+	for _, t := range tags {
+		elements = append(elements, types.StringValue(t.ID))
+	}
+	return types.SetValueMust(types.StringType, elements), nil
+}
+
+// requestGetTeamMembers returns the members of a team sorted by user ID, so
+// their order doesn't change between reads. Members are only read when
+// readMembers is true, it takes a request per page of 100 members.
+func requestGetTeamMembers(ctx context.Context, client *pagerduty.Client, teamID string, readMembers types.Bool) (types.List, error) {
+	if !readMembers.ValueBool() {
+		return types.ListNull(teamMemberObjectType), nil
+	}
+
+	var members []pagerduty.Member
+	err := apiutil.All(ctx, func(offset int) (bool, error) {
+		resp, err := client.ListTeamMembers(ctx, teamID, pagerduty.ListTeamMembersOptions{
+			Limit:  apiutil.Limit,
+			Offset: uint(offset),
+		})
+		if err != nil {
+			return false, err
+		}
+		members = append(members, resp.Members...)
+		return resp.More, nil
+	})
+	if err != nil {
+		return types.ListNull(teamMemberObjectType), err
+	}
+
+	sort.Slice(members, func(i, j int) bool { return members[i].User.ID < members[j].User.ID })
+	elements := make([]attr.Value, 0, len(members))
+	for _, m := range members {
+		elements = append(elements, types.ObjectValueMust(teamMemberObjectType.AttrTypes, map[string]attr.Value{
+			"user_id": types.StringValue(m.User.ID),
+			"role":    types.StringValue(m.Role),
+		}))
+	}
+	return types.ListValueMust(teamMemberObjectType, elements), nil
+}
+
+// requestGetTeamDependents returns the escalation policies and the services
+// attached to a team, which make the API refuse to delete it.
+func requestGetTeamDependents(ctx context.Context, client *pagerduty.Client, teamID string) ([]pagerduty.EscalationPolicy, []pagerduty.Service, error) {
+	var escalationPolicies []pagerduty.EscalationPolicy
+	err := apiutil.All(ctx, func(offset int) (bool, error) {
+		resp, err := client.ListEscalationPoliciesWithContext(ctx, pagerduty.ListEscalationPoliciesOptions{
+			TeamIDs: []string{teamID},
+			Limit:   apiutil.Limit,
+			Offset:  uint(offset),
+		})
+		if err != nil {
+			return false, err
+		}
+		escalationPolicies = append(escalationPolicies, resp.EscalationPolicies...)
+		return resp.More, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var services []pagerduty.Service
+	err = apiutil.All(ctx, func(offset int) (bool, error) {
+		resp, err := client.ListServicesWithContext(ctx, pagerduty.ListServiceOptions{
+			TeamIDs: []string{teamID},
+			Limit:   apiutil.Limit,
+			Offset:  uint(offset),
+		})
+		if err != nil {
+			return false, err
+		}
+		services = append(services, resp.Services...)
+		return resp.More, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return escalationPolicies, services, nil
+}
+
+// requestDetachTeamEscalationPolicies removes the escalation policies from a
+// team, the services using them leave the team along with them.
+func requestDetachTeamEscalationPolicies(ctx context.Context, client *pagerduty.Client, teamID string, escalationPolicies []pagerduty.EscalationPolicy) error {
+	for _, ep := range escalationPolicies {
+		log.Printf("[INFO] Detaching escalation policy %s from PagerDuty team %s", ep.ID, teamID)
+		err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+			err := client.RemoveEscalationPolicyFromTeamWithContext(ctx, teamID, ep.ID)
+			if err != nil {
+				if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+					return retry.NonRetryableError(err)
+				}
+				return retry.RetryableError(err)
+			}
+			return nil
+		})
+		if err != nil && !util.IsNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// teamDeleteTimeout bounds the wait for the objects attached to a team being
+// deleted to be detached from it.
+var teamDeleteTimeout = 2 * time.Minute
+
+// teamDependentsError is returned while objects are attached to a team being
+// deleted without force_destroy.
+type teamDependentsError struct {
+	escalationPolicies []pagerduty.EscalationPolicy
+	services           []pagerduty.Service
+}
+
+func (e *teamDependentsError) Error() string {
+	return describeTeamDependents(e.escalationPolicies, e.services)
+}
+
+func describeTeamDependents(escalationPolicies []pagerduty.EscalationPolicy, services []pagerduty.Service) string {
+	var parts []string
+	if len(escalationPolicies) > 0 {
+		names := make([]string, 0, len(escalationPolicies))
+		for _, ep := range escalationPolicies {
+			names = append(names, fmt.Sprintf("%q (%s)", ep.Name, ep.ID))
+		}
+		parts = append(parts, fmt.Sprintf("Escalation policies: %s.", strings.Join(names, ", ")))
+	}
+	if len(services) > 0 {
+		names := make([]string, 0, len(services))
+		for _, s := range services {
+			names = append(names, fmt.Sprintf("%q (%s)", s.Name, s.ID))
+		}
+		parts = append(parts, fmt.Sprintf("Services: %s.", strings.Join(names, ", ")))
+	}
+	return strings.Join(parts, " ")
+}
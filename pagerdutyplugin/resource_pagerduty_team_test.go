@@ -4,10 +4,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -57,6 +64,10 @@ func TestAccPagerDutyTeam_Basic(t *testing.T) {
 				Config: testAccCheckPagerDutyTeamConfig(team),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPagerDutyTeamExists("pagerduty_team.foo"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_team.foo", "html_url"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_team.foo", "self"),
 					resource.TestCheckResourceAttr(
 						"pagerduty_team.foo", "name", team),
 					resource.TestCheckResourceAttr(
@@ -126,6 +137,18 @@ func TestAccPagerDutyTeam_DefaultRole(t *testing.T) {
 						"pagerduty_team.foo", "default_role", defaultRoleUpdated),
 				),
 			},
+			{
+				Config: testAccCheckPagerDutyTeamDefaultRoleConfig(team, defaultRole),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "default_role", defaultRole),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyTeamDefaultRoleConfig(team, "observer"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be one of: \["manager" "none"\]`),
+			},
 		},
 	})
 }
@@ -160,6 +183,190 @@ func TestAccPagerDutyTeam_Parent(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyTeam_Tags(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	tag := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamWithTagsConfig(team, tag, "pagerduty_tag.foo.id, pagerduty_tag.bar.id"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTeamExists("pagerduty_team.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"pagerduty_team.foo", "tags.*", "pagerduty_tag.foo", "id"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"pagerduty_team.foo", "tags.*", "pagerduty_tag.bar", "id"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTeamWithTagsConfig(team, tag, "pagerduty_tag.bar.id, pagerduty_tag.baz.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"pagerduty_team.foo", "tags.*", "pagerduty_tag.bar", "id"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"pagerduty_team.foo", "tags.*", "pagerduty_tag.baz", "id"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTeamWithTagsConfig(team, tag, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyTeam_DeleteWithDependents(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicyID := new(string)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTeamWithUserConfig(team, username, email, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExternallyCreateTeamEscalationPolicy("pagerduty_team.foo", "pagerduty_user.foo", escalationPolicyID),
+				),
+			},
+			{
+				Config:      testAccCheckPagerDutyTeamUserOnlyConfig(username, email),
+				ExpectError: regexp.MustCompile(`can't be deleted while objects are attached to it`),
+			},
+			{
+				Config: testAccCheckPagerDutyTeamWithUserConfig(team, username, email, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_team.foo", "force_destroy", "true"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyTeamUserOnlyConfig(username, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccExternallyDestroyEscalationPolicy(escalationPolicyID),
+				),
+			},
+		},
+	})
+}
+
+func TestResourcePagerDutyTeamDelete_Dependents(t *testing.T) {
+	defer func(timeout time.Duration) { teamDeleteTimeout = timeout }(teamDeleteTimeout)
+	teamDeleteTimeout = 2 * time.Second
+
+	var detached, deleted bool
+	attached := -1 // The objects are attached for every listing when negative
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/escalation_policies":
+			if got := r.URL.Query().Get("team_ids[]"); got != "PTEAM01" {
+				t.Errorf("expected the escalation policies of the team to be listed, got team_ids %q", got)
+			}
+			if attached == 0 {
+				w.Write([]byte(`{"escalation_policies":[],"more":false}`))
+				return
+			}
+			w.Write([]byte(`{"escalation_policies":[{"id":"PEP0001","name":"Primary"}],"more":false}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/services":
+			if attached == 0 {
+				w.Write([]byte(`{"services":[],"more":false}`))
+				return
+			}
+			if attached > 0 {
+				attached--
+			}
+			w.Write([]byte(`{"services":[{"id":"PSVC001","name":"Checkout"},{"id":"PSVC002","name":"Billing"}],"more":false}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/teams/PTEAM01/escalation_policies/PEP0001":
+			detached = true
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && r.URL.Path == "/teams/PTEAM01":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &resourceTeam{client: pagerduty.NewClient("foo", pagerduty.WithAPIEndpoint(server.URL))}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	deleteTeam := func(forceDestroy bool) *fwresource.DeleteResponse {
+		detached, deleted = false, false
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := state.Set(ctx, &resourceTeamModel{
+			ID:           types.StringValue("PTEAM01"),
+			Name:         types.StringValue("Engineering"),
+			Tags:         types.SetNull(types.StringType),
+			ForceDestroy: types.BoolValue(forceDestroy),
+			Members:      types.ListNull(teamMemberObjectType),
+		}); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		resp := &fwresource.DeleteResponse{State: state}
+		r.Delete(ctx, fwresource.DeleteRequest{State: state}, resp)
+		return resp
+	}
+
+	resp := deleteTeam(false)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error deleting a team with escalation policies and services attached")
+	}
+	detail := resp.Diagnostics.Errors()[0].Detail()
+	for _, name := range []string{`"Primary" (PEP0001)`, `"Checkout" (PSVC001)`, `"Billing" (PSVC002)`} {
+		if !strings.Contains(detail, name) {
+			t.Errorf("expected the error to name %s, got %q", name, detail)
+		}
+	}
+	if detached || deleted {
+		t.Error("expected the team to be left as it is")
+	}
+
+	// The objects detached from the team while waiting don't stop it from
+	// being deleted.
+	attached = 1
+	resp = deleteTeam(false)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if detached {
+		t.Error("expected the escalation policy not to be detached without force_destroy")
+	}
+	if !deleted {
+		t.Error("expected the team to be deleted once its objects are detached")
+	}
+
+	attached = -1
+	resp = deleteTeam(true)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if !detached {
+		t.Error("expected the escalation policy to be detached from the team")
+	}
+	if !deleted {
+		t.Error("expected the team to be deleted")
+	}
+}
+
 func testAccCheckPagerDutyTeamDestroy(s *terraform.State) error {
 	ctx := context.Background()
 
@@ -229,6 +436,28 @@ resource "pagerduty_team" "foo" {
 }`, parent, team)
 }
 
+func testAccCheckPagerDutyTeamWithTagsConfig(team, tag, tags string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_tag" "foo" {
+  label = "%[2]s-foo"
+}
+
+resource "pagerduty_tag" "bar" {
+  label = "%[2]s-bar"
+}
+
+resource "pagerduty_tag" "baz" {
+  label = "%[2]s-baz"
+}
+
+resource "pagerduty_team" "foo" {
+  name        = "%[1]s"
+  description = "foo"
+  tags        = [%[3]s]
+}
+`, team, tag, tags)
+}
+
 func testAccExternallyDestroyTeam(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -248,3 +477,189 @@ func testAccExternallyDestroyTeam(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func testAccCheckPagerDutyTeamWithUserConfig(team, username, email string, forceDestroy bool) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[2]s"
+  email = "%[3]s"
+}
+
+resource "pagerduty_team" "foo" {
+  name          = "%[1]s"
+  description   = "foo"
+  force_destroy = %[4]t
+}
+`, team, username, email, forceDestroy)
+}
+
+func testAccCheckPagerDutyTeamUserOnlyConfig(username, email string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%s"
+  email = "%s"
+}
+`, username, email)
+}
+
+// testAccExternallyCreateTeamEscalationPolicy attaches an escalation policy
+// unknown to Terraform to the team, so the team can't be deleted.
+func testAccExternallyCreateTeamEscalationPolicy(team, user string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		teamState, ok := s.RootModule().Resources[team]
+		if !ok {
+			return fmt.Errorf("Not found: %s", team)
+		}
+		userState, ok := s.RootModule().Resources[user]
+		if !ok {
+			return fmt.Errorf("Not found: %s", user)
+		}
+
+		ctx := context.Background()
+		ep, err := testAccProvider.client.CreateEscalationPolicyWithContext(ctx, pagerduty.EscalationPolicy{
+			Name: fmt.Sprintf("tf-%s", acctest.RandString(5)),
+			EscalationRules: []pagerduty.EscalationRule{{
+				Delay:   10,
+				Targets: []pagerduty.APIObject{{ID: userState.Primary.ID, Type: "user_reference"}},
+			}},
+			Teams: []pagerduty.APIReference{{ID: teamState.Primary.ID, Type: "team_reference"}},
+		})
+		if err != nil {
+			return err
+		}
+		*id = ep.ID
+
+		return nil
+	}
+}
+
+func testAccExternallyDestroyEscalationPolicy(id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := context.Background()
+		return testAccProvider.client.DeleteEscalationPolicyWithContext(ctx, *id)
+	}
+}
+
+func TestResourcePagerDutyTeamRead_Members(t *testing.T) {
+	memberRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/teams/PTEAM01":
+			w.Write([]byte(`{"team":{"id":"PTEAM01","name":"Engineering","description":"Managed by Terraform","default_role":"manager"}}`))
+		case "/teams/PTEAM01/members":
+			memberRequests++
+			switch r.URL.Query().Get("offset") {
+			case "", "0":
+				w.Write([]byte(`{"members":[{"user":{"id":"PUSER02","type":"user_reference"},"role":"manager"}],"offset":0,"limit":1,"more":true}`))
+			default:
+				w.Write([]byte(`{"members":[{"user":{"id":"PUSER01","type":"user_reference"},"role":"observer"}],"offset":1,"limit":1,"more":false}`))
+			}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	r := &resourceTeam{client: pagerduty.NewClient("foo", pagerduty.WithAPIEndpoint(server.URL))}
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	readTeam := func(readMembers types.Bool) resourceTeamModel {
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := state.Set(ctx, &resourceTeamModel{
+			ID:          types.StringValue("PTEAM01"),
+			Name:        types.StringValue("Engineering"),
+			Tags:        types.SetNull(types.StringType),
+			ReadMembers: readMembers,
+			Members:     types.ListNull(teamMemberObjectType),
+		}); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		resp := &fwresource.ReadResponse{State: state}
+		r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+
+		var model resourceTeamModel
+		if diags := resp.State.Get(ctx, &model); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		return model
+	}
+
+	model := readTeam(types.BoolValue(true))
+	var members []struct {
+		UserID string `tfsdk:"user_id"`
+		Role   string `tfsdk:"role"`
+	}
+	if diags := model.Members.ElementsAs(ctx, &members, false); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(members) != 2 || members[0].UserID != "PUSER01" || members[0].Role != "observer" || members[1].UserID != "PUSER02" || members[1].Role != "manager" {
+		t.Errorf("expected the members of every page to be reported sorted by user ID, got %+v", members)
+	}
+	if !model.ReadMembers.ValueBool() {
+		t.Error("expected read_members to be kept")
+	}
+
+	memberRequests = 0
+	if model := readTeam(types.BoolNull()); !model.Members.IsNull() {
+		t.Errorf("expected the members not to be reported without read_members, got %v", model.Members)
+	}
+	if memberRequests > 0 {
+		t.Errorf("expected the members not to be listed without read_members, got %d requests", memberRequests)
+	}
+}
+
+func TestResourcePagerDutyTeamRead_StrictMissing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"message":"Not Found","code":2100}}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	var schemaResp fwresource.SchemaResponse
+	(&resourceTeam{}).Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	readTeam := func(strictMissing bool) *fwresource.ReadResponse {
+		r := &resourceTeam{
+			client:        pagerduty.NewClient("foo", pagerduty.WithAPIEndpoint(server.URL)),
+			strictMissing: strictMissing,
+		}
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := state.Set(ctx, &resourceTeamModel{
+			ID:          types.StringValue("PTEAM01"),
+			Name:        types.StringValue("Engineering"),
+			Tags:        types.SetNull(types.StringType),
+			ReadMembers: types.BoolNull(),
+			Members:     types.ListNull(teamMemberObjectType),
+		}); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		resp := &fwresource.ReadResponse{State: state}
+		r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+		return resp
+	}
+
+	resp := readTeam(false)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("expected the missing team to be removed from state")
+	}
+
+	resp = readTeam(true)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Summary(), "pagerduty_team PTEAM01 no longer exists") {
+		t.Errorf("expected the missing team to be reported with strict_missing, got %v", resp.Diagnostics)
+	}
+	if resp.State.Raw.IsNull() {
+		t.Error("expected the missing team to be kept in state with strict_missing")
+	}
+}
@@ -25,7 +25,8 @@ import (
 )
 
 type resourceUserHandoffNotificationRule struct {
-	client *pagerduty.Client
+	client        *pagerduty.Client
+	strictMissing bool
 }
 
 var (
@@ -152,11 +153,12 @@ func (r *resourceUserHandoffNotificationRule) Read(ctx context.Context, req reso
 	log.Printf("[INFO] Reading PagerDuty User Handoff Notification Rule %s", state.ID)
 
 	var diags diag.Diagnostics
-	state = requestGetUserHandoffNotificationRule(ctx, r.client, state.UserID.ValueString(), state.ID.ValueString(), &diags)
+	id := state.ID.ValueString()
+	state = requestGetUserHandoffNotificationRule(ctx, r.client, state.UserID.ValueString(), id, &diags)
 	if diags.HasError() {
 		for _, d := range diags.Errors() {
 			if d.Summary() == "resource not found." {
-				resp.State.RemoveResource(ctx)
+				removeMissingResource(ctx, "pagerduty_user_handoff_notification_rule", id, r.strictMissing, resp)
 				return
 			}
 		}
@@ -231,6 +233,7 @@ func (r *resourceUserHandoffNotificationRule) Delete(ctx context.Context, req re
 
 func (r *resourceUserHandoffNotificationRule) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	r.strictMissing = configuredProvider(req.ProviderData).strictMissing
 }
 
 func (r *resourceUserHandoffNotificationRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,61 @@
+package util
+
+// AvailableOauthScopes lists the scopes requested for the App OAuth scoped
+// tokens.
+func AvailableOauthScopes() []string {
+	return []string{
+		"abilities.read",
+		"addons.read",
+		"addons.write",
+		"analytics.read",
+		"audit_records.read",
+		"change_events.read",
+		"change_events.write",
+		"custom_fields.read",
+		"custom_fields.write",
+		"escalation_policies.read",
+		"escalation_policies.write",
+		"event_orchestrations.read",
+		"event_orchestrations.write",
+		"event_rules.read",
+		"event_rules.write",
+		"extension_schemas.read",
+		"extensions.read",
+		"extensions.write",
+		"incident_workflows.read",
+		"incident_workflows.write",
+		"incident_workflows:instances.write",
+		"incidents.read",
+		"incidents.write",
+		"licenses.read",
+		"notifications.read",
+		"oncalls.read",
+		"priorities.read",
+		"response_plays.read",
+		"response_plays.write",
+		"schedules.read",
+		"schedules.write",
+		"services.read",
+		"services.write",
+		"standards.read",
+		"standards.write",
+		"status_dashboards.read",
+		"status_pages.read",
+		"status_pages.write",
+		"subscribers.read",
+		"subscribers.write",
+		"tags.read",
+		"tags.write",
+		"teams.read",
+		"teams.write",
+		"templates.read",
+		"templates.write",
+		"users.read",
+		"users.write",
+		"users:contact_methods.read",
+		"users:contact_methods.write",
+		"users:sessions.read",
+		"users:sessions.write",
+		"vendors.read",
+	}
+}
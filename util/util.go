@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -74,6 +75,11 @@ func SuppressRFC3339Diff(k, oldTime, newTime string, d *schema.ResourceData) boo
 // start to the current time. Thus, we do not need to show diff if both newT and
 // oldT is in the past, as it will not bring
 // any real changes to the schedule layer.
+// PagerDuty may also move a past start forward to the upcoming rotation
+// boundary, in which case oldT is in the future. That is still the same start
+// as long as it's a whole number of turns after newT, i.e. the rotation phase
+// matches, and no more than one turn away from now, as that's as far as the
+// upcoming boundary can be.
 func SuppressScheduleLayerStartDiff(k, oldTime, newTime string, d *schema.ResourceData) bool {
 	oldT, newT, err := ParseRFC3339Time(k, oldTime, newTime)
 	if err != nil {
@@ -81,7 +87,35 @@ func SuppressScheduleLayerStartDiff(k, oldTime, newTime string, d *schema.Resour
 		return false
 	}
 
-	return oldT.Equal(newT) || (newT.Before(time.Now()) && oldT.Before(time.Now()))
+	now := time.Now()
+	if oldT.Equal(newT) || (newT.Before(now) && oldT.Before(now)) {
+		return true
+	}
+	if d == nil || !newT.Before(now) || !oldT.After(newT) {
+		return false
+	}
+
+	turnLengthKey := strings.TrimSuffix(k, "start") + "rotation_turn_length_seconds"
+	turnLength, ok := d.Get(turnLengthKey).(int)
+	if !ok || turnLength <= 0 {
+		return false
+	}
+
+	turn := time.Duration(turnLength) * time.Second
+	if oldT.Sub(now) > turn {
+		return false
+	}
+
+	return IsSameRotationPhase(oldT, newT, turn)
+}
+
+// IsSameRotationPhase reports whether a and b fall on the same point of a
+// rotation whose turns last turnLength.
+func IsSameRotationPhase(a, b time.Time, turnLength time.Duration) bool {
+	if turnLength <= 0 {
+		return false
+	}
+	return a.Sub(b)%turnLength == 0
 }
 
 func ParseRFC3339Time(k, oldTime, newTime string) (time.Time, time.Time, error) {
@@ -282,6 +316,36 @@ func Unique(s []string) []string {
 	return result
 }
 
+var pagerDutyIDRegexp = regexp.MustCompile(`^P[A-Z0-9]{6}$`)
+
+// IsPagerDutyID reports whether v has the format of a PagerDuty object ID,
+// e.g. "PXPGF42", which allows telling references by ID and by name apart.
+func IsPagerDutyID(v string) bool {
+	return pagerDutyIDRegexp.MatchString(v)
+}
+
+// Contains reports whether v is present in s.
+func Contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// StrictMissingSummary is the summary of the error reading the resource id of
+// type name fails with when PagerDuty doesn't find it while `strict_missing`
+// is enabled.
+func StrictMissingSummary(name, id string) string {
+	return fmt.Sprintf("%s %s no longer exists", name, id)
+}
+
+// StrictMissingDetail is the detail of the error of StrictMissingSummary.
+func StrictMissingDetail(name, id string) string {
+	return fmt.Sprintf("%s %s is still in the Terraform state but PagerDuty reports it as not found, it was probably deleted outside of Terraform. Because `strict_missing` is enabled it isn't removed from state automatically: recreate it, remove it from state with `terraform state rm`, or unset `strict_missing` to acknowledge its deletion.", name, id)
+}
+
 func ResourcePagerDutyParseColonCompoundID(id string) (string, string, error) {
 	parts := strings.Split(id, ":")
 
@@ -492,3 +556,15 @@ func CheckJSONEqual(expected string) resource.CheckResourceAttrWithFunc {
 		return nil
 	})
 }
+
+// SleepContext pauses the current goroutine for d, returning early when ctx
+// is done so canceled operations don't linger.
+func SleepContext(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
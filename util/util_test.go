@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestValidateTZValueDiagFunc(t *testing.T) {
@@ -41,3 +43,56 @@ func TestValidateTZValueDiagFunc(t *testing.T) {
 		}
 	}
 }
+
+// Reproduces the perpetual diff of schedule layers configured with a start in
+// the past, which PagerDuty moves forward to the upcoming rotation boundary.
+func TestSuppressScheduleLayerStartDiff(t *testing.T) {
+	turnLength := 7 * 24 * time.Hour
+	anchor := time.Now().Add(-30 * 24 * time.Hour).Truncate(time.Hour).UTC()
+	upcoming := anchor
+	for !upcoming.After(time.Now()) {
+		upcoming = upcoming.Add(turnLength)
+	}
+
+	layerSchema := map[string]*schema.Schema{
+		"layer": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"start":                        {Type: schema.TypeString, Optional: true},
+					"rotation_turn_length_seconds": {Type: schema.TypeInt, Optional: true},
+				},
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, layerSchema, map[string]interface{}{
+		"layer": []interface{}{
+			map[string]interface{}{
+				"start":                        anchor.Format(time.RFC3339),
+				"rotation_turn_length_seconds": int(turnLength.Seconds()),
+			},
+		},
+	})
+
+	cases := []struct {
+		name     string
+		oldTime  time.Time
+		newTime  time.Time
+		suppress bool
+	}{
+		{name: "same start", oldTime: anchor, newTime: anchor, suppress: true},
+		{name: "both in the past", oldTime: anchor.Add(time.Hour), newTime: anchor, suppress: true},
+		{name: "moved to the upcoming boundary", oldTime: upcoming, newTime: anchor, suppress: true},
+		{name: "further than the upcoming boundary", oldTime: upcoming.Add(turnLength), newTime: anchor, suppress: false},
+		{name: "phase doesn't match", oldTime: upcoming.Add(time.Hour), newTime: anchor, suppress: false},
+		{name: "future start changed", oldTime: upcoming, newTime: upcoming.Add(turnLength), suppress: false},
+	}
+
+	for _, c := range cases {
+		got := SuppressScheduleLayerStartDiff("layer.0.start", c.oldTime.Format(time.RFC3339), c.newTime.Format(time.RFC3339), d)
+		if got != c.suppress {
+			t.Errorf("%s: want %v; got %v", c.name, c.suppress, got)
+		}
+	}
+}